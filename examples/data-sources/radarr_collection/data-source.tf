@@ -0,0 +1,3 @@
+data "radarr_collection" "example" {
+  tmdb_id = 2344
+}
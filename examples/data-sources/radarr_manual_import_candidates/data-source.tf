@@ -0,0 +1,3 @@
+data "radarr_manual_import_candidates" "example" {
+  folder = "/downloads/complete"
+}
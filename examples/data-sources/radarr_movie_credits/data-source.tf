@@ -0,0 +1,17 @@
+data "radarr_movie_credits" "example" {
+  movie_id = 1
+}
+
+# follow the director of the movie
+resource "radarr_import_list_tmdb_person" "example" {
+  enabled              = true
+  enable_auto          = false
+  search_on_add        = false
+  root_folder_path     = "/movies"
+  monitor              = "movieOnly"
+  minimum_availability = "released"
+  quality_profile_id   = 1
+  name                 = "Director"
+  person_id            = tostring(one([for c in data.radarr_movie_credits.example.crew : c.person_tmdb_id if c.job == "Director"]))
+  cast_director        = true
+}
@@ -0,0 +1,3 @@
+data "radarr_movie_files" "example" {
+  movie_id = radarr_movie.example.id
+}
@@ -0,0 +1,11 @@
+data "radarr_movie_lookup" "example" {
+  term = "tmdb:603"
+}
+
+resource "radarr_movie" "example" {
+  monitored          = true
+  title              = data.radarr_movie_lookup.example.movies[0].title
+  tmdb_id            = data.radarr_movie_lookup.example.movies[0].tmdb_id
+  path               = "/movies/${data.radarr_movie_lookup.example.movies[0].folder}"
+  quality_profile_id = 1
+}
@@ -0,0 +1,8 @@
+data "radarr_naming_examples" "example" {
+  standard_movie_format = "{Movie Title} ({Release Year}) {Quality Full}"
+  movie_folder_format   = "{Movie Title} ({Release Year})"
+}
+
+output "movie_example" {
+  value = data.radarr_naming_examples.example.movie_example
+}
@@ -0,0 +1,10 @@
+data "radarr_rename_preview" "example" {
+  depends_on = [radarr_naming.example]
+}
+
+check "no_pending_renames" {
+  assert {
+    condition     = length(data.radarr_rename_preview.example.renames) == 0
+    error_message = "Naming settings would rename ${length(data.radarr_rename_preview.example.renames)} files."
+  }
+}
@@ -0,0 +1,14 @@
+output "movie_file_name" {
+  value = provider::radarr::render_movie_name(radarr_naming.example.standard_movie_format, radarr_movie.example)
+}
+
+output "sample_file_name" {
+  value = provider::radarr::render_movie_name("{Movie CleanTitle} {(Release Year)} {[Quality Full]}{-Release Group}", {
+    title = "The Movie: Title"
+    year  = 2010
+    movie_file = {
+      quality       = "Bluray-1080p"
+      release_group = "EVOLVE"
+    }
+  })
+}
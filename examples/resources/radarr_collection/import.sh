@@ -0,0 +1,8 @@
+# import using the API/UI ID
+terraform import radarr_collection.example 1
+
+# import using the TMDB collection ID
+terraform import radarr_collection.example tmdb:2344
+
+# import using the title
+terraform import radarr_collection.example "title=The Matrix Collection"
\ No newline at end of file
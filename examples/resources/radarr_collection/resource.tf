@@ -0,0 +1,9 @@
+resource "radarr_collection" "example" {
+  tmdb_id              = 2344
+  monitored            = true
+  quality_profile_id   = 1
+  root_folder_path     = "/movies"
+  minimum_availability = "released"
+  search_on_add        = true
+  tags                 = [1]
+}
@@ -0,0 +1,10 @@
+resource "radarr_command" "rename" {
+  name = "RenameMovie"
+  body = jsonencode({
+    movieIds = [radarr_movie.example.id]
+  })
+
+  triggers = {
+    format = radarr_naming.example.standard_movie_format
+  }
+}
@@ -0,0 +1,10 @@
+resource "radarr_manual_import" "example" {
+  import_mode = "copy"
+
+  files = [
+    for c in data.radarr_manual_import_candidates.example.candidates : {
+      path     = c.path
+      movie_id = c.movie_id
+    } if length(c.rejections) == 0
+  ]
+}
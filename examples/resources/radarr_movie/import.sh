@@ -1,2 +1,8 @@
 # import using the API/UI ID
-terraform import radarr_movie.example 10
\ No newline at end of file
+terraform import radarr_movie.example 10
+
+# import using the TMDB ID
+terraform import radarr_movie.example tmdb:603
+
+# import using the IMDB ID
+terraform import radarr_movie.example imdb:tt0133093
\ No newline at end of file
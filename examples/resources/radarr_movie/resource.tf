@@ -5,4 +5,21 @@ resource "radarr_movie" "example" {
   quality_profile_id   = 1
   tmdb_id              = 603
   minimum_availability = "inCinemas"
-}
\ No newline at end of file
+  delete_files         = true
+  add_import_exclusion = true
+
+  add_options = {
+    monitor          = "movieAndCollection"
+    search_for_movie = true
+  }
+}
+
+# path built by Radarr from the naming movie folder format
+resource "radarr_movie" "example_root_folder" {
+  monitored          = false
+  title              = "Pulp Fiction"
+  root_folder_path   = "/movies"
+  quality_profile_id = 1
+  tmdb_id            = 680
+  move_files         = true
+}
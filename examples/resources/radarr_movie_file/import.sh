@@ -0,0 +1,2 @@
+# import using the API/UI ID
+terraform import radarr_movie_file.example 1
\ No newline at end of file
@@ -0,0 +1,7 @@
+resource "radarr_movie_file" "example" {
+  id            = data.radarr_movie_files.example.files[0].id
+  quality_id    = 7
+  languages     = ["English", "Italian"]
+  edition       = "Director's Cut"
+  release_group = "GROUP"
+}
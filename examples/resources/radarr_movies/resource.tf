@@ -0,0 +1,9 @@
+resource "radarr_movies" "example" {
+  tmdb_ids             = [603, 604, 605]
+  quality_profile_id   = 1
+  root_folder_path     = "/movies"
+  monitored            = true
+  minimum_availability = "released"
+  tags                 = [1]
+  search_for_movie     = true
+}
@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import radarr_notification_slack.example 1
\ No newline at end of file
+terraform import radarr_notification_slack.example 1
+
+# import using the name
+terraform import radarr_notification_slack.example name=Example
\ No newline at end of file
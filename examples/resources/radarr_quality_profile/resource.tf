@@ -1,7 +1,7 @@
 resource "radarr_quality_profile" "example" {
   name            = "example-4k"
   upgrade_allowed = true
-  cutoff          = 1100
+  cutoff_name     = "4k"
 
   language = {
     id   = 1
@@ -10,22 +10,14 @@ resource "radarr_quality_profile" "example" {
 
   quality_groups = [
     {
-      id   = 1100
       name = "4k"
       qualities = [
-        {
-          id         = 18
-          name       = "WEBDL-2160p"
-          source     = "web"
-          resolution = 2160
-        },
-        {
-          id         = 19
-          name       = "Bluray-2160p"
-          source     = "bluray"
-          resolution = 2160
-        }
+        { name = "WEBDL-2160p" },
+        { name = "Bluray-2160p" }
       ]
+    },
+    {
+      qualities = [{ name = "Bluray-1080p" }]
     }
   ]
-}
\ No newline at end of file
+}
@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import radarr_root_folder.example 10
\ No newline at end of file
+terraform import radarr_root_folder.example 10
+
+# import using the path
+terraform import radarr_root_folder.example path=/movies
\ No newline at end of file
@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import radarr_tag.example 10
\ No newline at end of file
+terraform import radarr_tag.example 10
+
+# import using the label
+terraform import radarr_tag.example label=1080p
\ No newline at end of file
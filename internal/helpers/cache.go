@@ -0,0 +1,245 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var errNotCacheable = errors.New("response not cacheable")
+
+// itemPathRegexp matches the path of a single item read by ID.
+var itemPathRegexp = regexp.MustCompile(`^(.*/api/v3/([a-z]+))/(\d+)$`)
+
+// resourcePathRegexp matches the API resource name of a path.
+var resourcePathRegexp = regexp.MustCompile(`/api/v3/([a-z]+)(/|$)`)
+
+// volatileResources lists the API resources whose state changes on the Radarr side, they are never cached.
+var volatileResources = []string{
+	"command",
+}
+
+// listBackedResources lists the API resources whose list response holds the same items returned by a read by ID.
+var listBackedResources = []string{
+	"autotagging",
+	"collection",
+	"customformat",
+	"delayprofile",
+	"downloadclient",
+	"exclusions",
+	"importlist",
+	"indexer",
+	"language",
+	"metadata",
+	"movie",
+	"notification",
+	"qualitydefinition",
+	"qualityprofile",
+	"releaseprofile",
+	"remotepathmapping",
+	"rootfolder",
+	"tag",
+}
+
+// CacheTransport is a http.RoundTripper caching Radarr read calls for the lifetime of the provider process.
+// Identical concurrent reads are sent once, reads by ID of list backed resources are served from the list response
+// and any other method invalidates the whole cache. Volatile resources, like commands, are always read from Radarr.
+type CacheTransport struct {
+	Transport http.RoundTripper
+	entries   map[string]*cacheEntry
+	mu        sync.Mutex
+}
+
+// cacheEntry is a single cached response, done is closed once it is filled.
+type cacheEntry struct {
+	err    error
+	done   chan struct{}
+	header http.Header
+	items  map[string]json.RawMessage
+	body   []byte
+	once   sync.Once
+	status int
+}
+
+// RoundTrip executes a single HTTP transaction, serving it from the cache when possible.
+func (t *CacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		// reads started while the mutation is in progress might be stale, so invalidate both before and after.
+		t.Invalidate()
+		defer t.Invalidate()
+
+		return t.Transport.RoundTrip(req)
+	}
+
+	if match := resourcePathRegexp.FindStringSubmatch(req.URL.Path); match != nil && slices.Contains(volatileResources, match[1]) {
+		return t.Transport.RoundTrip(req)
+	}
+
+	if match := itemPathRegexp.FindStringSubmatch(req.URL.Path); match != nil && req.URL.RawQuery == "" && slices.Contains(listBackedResources, match[2]) {
+		list := req.Clone(req.Context())
+		list.URL.Path = match[1]
+		list.URL.RawPath = ""
+
+		entry, resp, err := t.load(list)
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		if err == nil && entry != nil {
+			if item, ok := entry.item(match[3]); ok {
+				tflog.Debug(req.Context(), "Radarr request served from cached list", map[string]interface{}{"path": req.URL.Path})
+
+				return entry.response(req, item), nil
+			}
+		}
+	}
+
+	entry, resp, err := t.load(req)
+	if entry == nil {
+		return resp, err
+	}
+
+	return entry.response(req, entry.body), nil
+}
+
+// Invalidate drops all the cached responses.
+func (t *CacheTransport) Invalidate() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.entries = nil
+}
+
+// load returns the cached entry for the request, sending it if no identical request was sent yet.
+// If the response cannot be cached, the entry is nil and the response is returned as is.
+func (t *CacheTransport) load(req *http.Request) (*cacheEntry, *http.Response, error) {
+	key := req.URL.String()
+
+	t.mu.Lock()
+
+	if t.entries == nil {
+		t.entries = make(map[string]*cacheEntry)
+	}
+
+	entry, found := t.entries[key]
+	if !found {
+		entry = &cacheEntry{done: make(chan struct{})}
+		t.entries[key] = entry
+	}
+
+	t.mu.Unlock()
+
+	if found {
+		select {
+		case <-entry.done:
+		case <-req.Context().Done():
+			return nil, nil, req.Context().Err()
+		}
+
+		if entry.err == nil {
+			return entry, nil, nil
+		}
+
+		// the shared request failed, send a dedicated one to get its own error.
+		resp, err := t.Transport.RoundTrip(req)
+
+		return nil, resp, err
+	}
+
+	resp, err := t.Transport.RoundTrip(req)
+	entry.fill(resp, err)
+	close(entry.done)
+
+	if entry.err != nil {
+		t.mu.Lock()
+		if t.entries[key] == entry {
+			delete(t.entries, key)
+		}
+		t.mu.Unlock()
+
+		return nil, resp, err
+	}
+
+	return entry, nil, nil
+}
+
+// fill stores the response, only successful responses can be cached.
+func (e *cacheEntry) fill(resp *http.Response, err error) {
+	if err != nil {
+		e.err = err
+
+		return
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		e.err = errNotCacheable
+
+		return
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		e.err = err
+
+		return
+	}
+
+	e.status = resp.StatusCode
+	e.header = resp.Header.Clone()
+	e.body = body
+}
+
+// item returns a single item of a cached list response by ID.
+func (e *cacheEntry) item(id string) (json.RawMessage, bool) {
+	e.once.Do(func() {
+		var list []json.RawMessage
+		if err := json.Unmarshal(e.body, &list); err != nil {
+			return
+		}
+
+		e.items = make(map[string]json.RawMessage, len(list))
+
+		for _, raw := range list {
+			var item struct {
+				ID *json.Number `json:"id"`
+			}
+
+			if err := json.Unmarshal(raw, &item); err == nil && item.ID != nil {
+				e.items[item.ID.String()] = raw
+			}
+		}
+	})
+
+	item, ok := e.items[id]
+
+	return item, ok
+}
+
+// response builds a new response from the cached entry.
+func (e *cacheEntry) response(req *http.Request, body []byte) *http.Response {
+	header := e.header.Clone()
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+
+	return &http.Response{
+		Status:        strconv.Itoa(e.status) + " " + http.StatusText(e.status),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
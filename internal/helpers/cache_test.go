@@ -0,0 +1,108 @@
+package helpers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheTransport(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calls = make(map[string]int)
+		mu    sync.Mutex
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls[r.Method+" "+r.URL.Path]++
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/api/v3/tag":
+			time.Sleep(50 * time.Millisecond)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[{"id":1,"label":"one"},{"id":2,"label":"two"}]`))
+		case "/api/v3/tag/3":
+			w.WriteHeader(http.StatusNotFound)
+		case "/api/v3/system/status":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	count := func(key string) int {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return calls[key]
+	}
+
+	get := func(client *http.Client, path string) (int, string) {
+		resp, err := client.Get(server.URL + path)
+		assert.NoError(t, err)
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		assert.NoError(t, err)
+
+		return resp.StatusCode, string(body)
+	}
+
+	client := &http.Client{Transport: &CacheTransport{Transport: http.DefaultTransport}}
+
+	// identical concurrent reads are sent once.
+	var wg sync.WaitGroup
+
+	for range 5 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, body := get(client, "/api/v3/tag")
+			assert.Equal(t, `[{"id":1,"label":"one"},{"id":2,"label":"two"}]`, body)
+		}()
+	}
+
+	wg.Wait()
+	assert.Equal(t, 1, count("GET /api/v3/tag"))
+
+	// reads by ID are served from the list.
+	status, body := get(client, "/api/v3/tag/2")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, `{"id":2,"label":"two"}`, body)
+	assert.Equal(t, 0, count("GET /api/v3/tag/2"))
+
+	// missing items are read from Radarr.
+	status, _ = get(client, "/api/v3/tag/3")
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.Equal(t, 1, count("GET /api/v3/tag/3"))
+
+	// failures are not cached.
+	status, _ = get(client, "/api/v3/system/status")
+	assert.Equal(t, http.StatusInternalServerError, status)
+	_, _ = get(client, "/api/v3/system/status")
+	assert.Equal(t, 2, count("GET /api/v3/system/status"))
+
+	// volatile resources are always read from Radarr.
+	_, _ = get(client, "/api/v3/command/1")
+	_, _ = get(client, "/api/v3/command/1")
+	assert.Equal(t, 2, count("GET /api/v3/command/1"))
+
+	// writes invalidate the cache.
+	resp, err := client.Post(server.URL+"/api/v3/tag", "application/json", nil)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	_, _ = get(client, "/api/v3/tag/1")
+	assert.Equal(t, 2, count("GET /api/v3/tag"))
+}
@@ -1,9 +1,18 @@
 package helpers
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
 
 	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // define constant for error management.
@@ -19,8 +28,18 @@ const (
 	UnexpectedImportIdentifier        = "Unexpected Import Identifier"
 	UnexpectedResourceConfigureType   = "Unexpected Resource Configure Type"
 	UnexpectedDataSourceConfigureType = "Unexpected DataSource Configure Type"
+	ValidationError                   = "Validation Error"
+	ValidationWarning                 = "Validation Warning"
 )
 
+// validationFailure is a single entry of the Radarr validation error response.
+type validationFailure struct {
+	PropertyName string `json:"propertyName"`
+	ErrorMessage string `json:"errorMessage"`
+	Severity     string `json:"severity"`
+	IsWarning    bool   `json:"isWarning"`
+}
+
 func ParseNotFoundError(kind, field, search string) string {
 	return fmt.Sprintf("Unable to find %s, got error: data source not found: no %s with %s '%s'", kind, kind, field, search)
 }
@@ -32,3 +51,136 @@ func ParseClientError(action, name string, err error) string {
 
 	return fmt.Sprintf("Unable to %s %s, got error: %s", action, name, err)
 }
+
+// IsNotFound checks whether the API call failed because the object does not exist.
+func IsNotFound(httpResp *http.Response, err error) bool {
+	var apiErr *radarr.GenericOpenAPIError
+
+	return errors.As(err, &apiErr) && httpResp != nil && httpResp.StatusCode == http.StatusNotFound
+}
+
+// AddClientError adds the diagnostics of a failed API call.
+// Radarr validation failures are reported on the attribute they refer to, when it exists in source.
+func AddClientError(ctx context.Context, diags *diag.Diagnostics, source AttributeGetter, action, name string, err error) {
+	failures := parseValidationFailures(err)
+	if len(failures) == 0 {
+		diags.AddError(ClientError, ParseClientError(action, name, err))
+
+		return
+	}
+
+	hasError := false
+
+	for _, failure := range failures {
+		warning := failure.IsWarning || strings.EqualFold(failure.Severity, "warning")
+		detail := fmt.Sprintf("Unable to %s %s: %s", action, name, failure.ErrorMessage)
+		attribute, found := validationPath(ctx, source, failure.PropertyName)
+
+		if !found && failure.PropertyName != "" {
+			detail = fmt.Sprintf("Unable to %s %s, %s: %s", action, name, failure.PropertyName, failure.ErrorMessage)
+		}
+
+		switch {
+		case warning && found:
+			diags.AddAttributeWarning(attribute, ValidationWarning, detail)
+		case warning:
+			diags.AddWarning(ValidationWarning, detail)
+		case found:
+			hasError = true
+
+			diags.AddAttributeError(attribute, ValidationError, detail)
+		default:
+			hasError = true
+
+			diags.AddError(ValidationError, detail)
+		}
+	}
+
+	// Radarr rejects the payload on warnings too.
+	if !hasError {
+		diags.AddError(ClientError, ParseClientError(action, name, err))
+	}
+}
+
+// bodyError is implemented by the API errors holding the response body.
+type bodyError interface {
+	error
+	Body() []byte
+}
+
+// parseValidationFailures extracts the validation failures from an API error, if any.
+func parseValidationFailures(err error) []validationFailure {
+	var apiErr bodyError
+	if !errors.As(err, &apiErr) {
+		return nil
+	}
+
+	var failures []validationFailure
+	if json.Unmarshal(apiErr.Body(), &failures) != nil {
+		return nil
+	}
+
+	return failures
+}
+
+// validationPath maps a Radarr property name to the attribute path, checking that it exists in source.
+func validationPath(ctx context.Context, source AttributeGetter, propertyName string) (path.Path, bool) {
+	if propertyName == "" || source == nil {
+		return path.Empty(), false
+	}
+
+	// Provider settings are validated as a nested object.
+	name := strings.TrimPrefix(propertyName, "Settings.")
+
+	// Use the API naming for field exceptions (e.g. `SeedCriteria.SeedTime`).
+	segments := strings.Split(name, ".")
+	for i := range segments {
+		segments[i] = lowerFirst(segments[i])
+	}
+
+	name = selectTFName(strings.Join(segments, "."))
+
+	// Only the root attribute can be mapped for nested properties (e.g. `Items[0].Allowed`).
+	if i := strings.IndexAny(name, ".["); i > 0 {
+		name = name[:i]
+	}
+
+	attribute := path.Root(toSnakeCase(name))
+
+	var value attr.Value
+	if diags := source.GetAttribute(ctx, attribute, &value); diags.HasError() {
+		return path.Empty(), false
+	}
+
+	return attribute, true
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	runes := []rune(s)
+	runes[0] = unicode.ToLower(runes[0])
+
+	return string(runes)
+}
+
+// toSnakeCase converts an API camel case name to the terraform snake case one.
+func toSnakeCase(s string) string {
+	var builder strings.Builder
+
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				builder.WriteRune('_')
+			}
+
+			r = unicode.ToLower(r)
+		}
+
+		builder.WriteRune(r)
+	}
+
+	return builder.String()
+}
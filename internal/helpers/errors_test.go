@@ -1,10 +1,18 @@
 package helpers
 
 import (
+	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -65,3 +73,145 @@ func TestParseNotFoundError(t *testing.T) {
 		})
 	}
 }
+
+func TestIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		resp     *http.Response
+		err      error
+		expected bool
+	}{
+		"not found": {
+			resp:     &http.Response{StatusCode: http.StatusNotFound},
+			err:      &radarr.GenericOpenAPIError{},
+			expected: true,
+		},
+		"server error": {
+			resp:     &http.Response{StatusCode: http.StatusInternalServerError},
+			err:      &radarr.GenericOpenAPIError{},
+			expected: false,
+		},
+		"generic": {
+			resp:     &http.Response{StatusCode: http.StatusNotFound},
+			err:      errors.New("other error"),
+			expected: false,
+		},
+		"no response": {
+			resp:     nil,
+			err:      &radarr.GenericOpenAPIError{},
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, IsNotFound(test.resp, test.err))
+		})
+	}
+}
+
+func TestAddClientError(t *testing.T) {
+	t.Parallel()
+
+	plan := tfsdk.Plan{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"base_url":  schema.StringAttribute{Optional: true},
+				"seed_time": schema.Int64Attribute{Optional: true},
+			},
+		},
+		Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"base_url":  tftypes.String,
+			"seed_time": tftypes.Number,
+		}}, map[string]tftypes.Value{
+			"base_url":  tftypes.NewValue(tftypes.String, "/test"),
+			"seed_time": tftypes.NewValue(tftypes.Number, nil),
+		}),
+	}
+
+	tests := map[string]struct {
+		body     string
+		errors   []path.Path
+		warnings []path.Path
+		count    int
+	}{
+		"not validation": {
+			body:   `{"message":"internal error"}`,
+			errors: []path.Path{path.Empty()},
+			count:  1,
+		},
+		"attribute error": {
+			body:   `[{"propertyName":"BaseUrl","errorMessage":"invalid","severity":"error","isWarning":false}]`,
+			errors: []path.Path{path.Root("base_url")},
+			count:  1,
+		},
+		"field exception": {
+			body:   `[{"propertyName":"SeedCriteria.SeedTime","errorMessage":"invalid","severity":"error","isWarning":false}]`,
+			errors: []path.Path{path.Root("seed_time")},
+			count:  1,
+		},
+		"unknown attribute": {
+			body:   `[{"propertyName":"Other","errorMessage":"invalid","severity":"error","isWarning":false}]`,
+			errors: []path.Path{path.Empty()},
+			count:  1,
+		},
+		"warning": {
+			body:     `[{"propertyName":"Settings.BaseUrl","errorMessage":"check it","severity":"warning","isWarning":true}]`,
+			warnings: []path.Path{path.Root("base_url")},
+			errors:   []path.Path{path.Empty()},
+			count:    2,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			config := radarr.NewConfiguration()
+			config.Servers = radarr.ServerConfigurations{{URL: server.URL}}
+			_, _, err := radarr.NewAPIClient(config).TagAPI.CreateTag(context.Background()).TagResource(*radarr.NewTagResource()).Execute()
+			assert.Error(t, err)
+
+			diags := diag.Diagnostics{}
+			AddClientError(context.Background(), &diags, plan, Create, "radarr_tag", err)
+
+			assert.Len(t, diags, test.count)
+			assert.Len(t, diags.Errors(), len(test.errors))
+			assert.Len(t, diags.Warnings(), len(test.warnings))
+
+			for i, expected := range test.errors {
+				assertDiagnosticPath(t, expected, diags.Errors()[i])
+			}
+
+			for i, expected := range test.warnings {
+				assertDiagnosticPath(t, expected, diags.Warnings()[i])
+			}
+		})
+	}
+}
+
+func assertDiagnosticPath(t *testing.T, expected path.Path, diagnostic diag.Diagnostic) {
+	t.Helper()
+
+	withPath, ok := diagnostic.(diag.DiagnosticWithPath)
+	if expected.Equal(path.Empty()) {
+		assert.False(t, ok)
+
+		return
+	}
+
+	if assert.True(t, ok) {
+		assert.True(t, expected.Equal(withPath.Path()))
+	}
+}
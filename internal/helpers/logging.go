@@ -0,0 +1,213 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// HTTPSubsystem is the tflog subsystem used to log Radarr API calls.
+	// Its level can be set via the TF_LOG_PROVIDER_RADARR_HTTP environment variable.
+	HTTPSubsystem = "http"
+	// maxLoggedBody is the maximum number of bytes of a body that will be logged.
+	maxLoggedBody = 64 * 1024
+)
+
+// sensitiveHeaders lists the headers that must never be logged.
+var sensitiveHeaders = []string{"X-Api-Key", "Authorization", "Cookie", "Set-Cookie"}
+
+// sensitiveFields lists the API names of the fields that must never be logged.
+var sensitiveFields = []string{
+	"accesstoken",
+	"accesstokensecret",
+	"apikey",
+	"apptoken",
+	"authpassword",
+	"authtoken",
+	"bottoken",
+	"certpassword",
+	"configurationkey",
+	"consumerkey",
+	"consumersecret",
+	"encryptedpassword",
+	"key",
+	"passkey",
+	"password",
+	"refreshtoken",
+	"secrettoken",
+	"userkey",
+}
+
+// logLevelEnvs lists the environment variables setting the HTTP subsystem log level, by precedence.
+var logLevelEnvs = []string{"TF_LOG_PROVIDER_RADARR_HTTP", "TF_LOG_PROVIDER_RADARR", "TF_LOG_PROVIDER", "TF_LOG"}
+
+// LoggingTransport is a http.RoundTripper logging Radarr requests and responses with sensitive data redacted.
+// Method, path, status and duration are logged at debug level, JSON bodies at trace level.
+type LoggingTransport struct {
+	Transport http.RoundTripper
+	// LogBodies enables reading and redacting the bodies, which is costly for large responses.
+	LogBodies bool
+}
+
+// HTTPTraceEnabled reports whether the HTTP subsystem logs at trace level, where the bodies are logged.
+func HTTPTraceEnabled() bool {
+	for _, env := range logLevelEnvs {
+		if level := os.Getenv(env); level != "" {
+			return strings.EqualFold(level, "trace") || strings.EqualFold(level, "json")
+		}
+	}
+
+	return false
+}
+
+// RoundTrip executes a single HTTP transaction, logging it.
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), HTTPSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_RADARR", HTTPSubsystem))
+
+	fields := map[string]interface{}{
+		"method":  req.Method,
+		"path":    req.URL.Path,
+		"headers": redactHeaders(req.Header),
+	}
+
+	if t.LogBodies && req.Body != nil && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			body.Close()
+
+			fields["body"] = RedactBody(data, req.Header.Get("Content-Type"))
+		}
+	}
+
+	tflog.SubsystemTrace(ctx, HTTPSubsystem, "sending Radarr request", fields)
+
+	start := time.Now()
+	resp, err := t.Transport.RoundTrip(req)
+
+	fields = map[string]interface{}{
+		"method":   req.Method,
+		"path":     req.URL.Path,
+		"duration": time.Since(start).String(),
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, HTTPSubsystem, "Radarr request failed", fields)
+
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	tflog.SubsystemDebug(ctx, HTTPSubsystem, "Radarr request completed", fields)
+
+	if t.LogBodies && resp.Body != nil {
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+
+		if err != nil {
+			return nil, err
+		}
+
+		tflog.SubsystemTrace(ctx, HTTPSubsystem, "received Radarr response", map[string]interface{}{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"status":  resp.StatusCode,
+			"headers": redactHeaders(resp.Header),
+			"body":    RedactBody(data, resp.Header.Get("Content-Type")),
+		})
+	}
+
+	return resp, nil
+}
+
+// redactHeaders returns a copy of the headers with the sensitive ones redacted.
+func redactHeaders(headers http.Header) map[string]string {
+	output := make(map[string]string, len(headers))
+
+	for name, values := range headers {
+		if slices.ContainsFunc(sensitiveHeaders, func(s string) bool { return strings.EqualFold(s, name) }) {
+			output[name] = SensitiveValue
+
+			continue
+		}
+
+		output[name] = strings.Join(values, ", ")
+	}
+
+	return output
+}
+
+// RedactBody returns a loggable version of a JSON body with the sensitive fields redacted.
+// Non JSON bodies are not logged.
+func RedactBody(data []byte, contentType string) string {
+	if len(data) == 0 {
+		return ""
+	}
+
+	if !strings.Contains(contentType, "json") {
+		return "<non JSON body omitted>"
+	}
+
+	var body interface{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return "<invalid JSON body omitted>"
+	}
+
+	redacted, err := json.Marshal(redactValue(body))
+	if err != nil {
+		return "<invalid JSON body omitted>"
+	}
+
+	if len(redacted) > maxLoggedBody {
+		return string(redacted[:maxLoggedBody]) + "...<truncated>"
+	}
+
+	return string(redacted)
+}
+
+// redactValue walks a decoded JSON value redacting sensitive keys and provider fields (`{"name": "password", "value": "..."}`).
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if name, ok := v["name"].(string); ok && isSensitiveField(name) {
+			if _, ok := v["value"]; ok {
+				v["value"] = SensitiveValue
+			}
+		}
+
+		for key, item := range v {
+			if isSensitiveField(key) {
+				if item != nil && item != "" {
+					v[key] = SensitiveValue
+				}
+
+				continue
+			}
+
+			v[key] = redactValue(item)
+		}
+
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+
+		return v
+	default:
+		return v
+	}
+}
+
+func isSensitiveField(name string) bool {
+	return slices.Contains(sensitiveFields, strings.ToLower(name))
+}
@@ -0,0 +1,132 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body        string
+		contentType string
+		expected    string
+	}{
+		"empty": {
+			body:        "",
+			contentType: "application/json",
+			expected:    "",
+		},
+		"not json": {
+			body:        "<html></html>",
+			contentType: "text/html",
+			expected:    "<non JSON body omitted>",
+		},
+		"sensitive key": {
+			body:        `{"apiKey":"secret","urlBase":"/radarr"}`,
+			contentType: "application/json; charset=utf-8",
+			expected:    `{"apiKey":"********","urlBase":"/radarr"}`,
+		},
+		"sensitive field": {
+			body:        `{"fields":[{"name":"password","value":"secret"},{"name":"host","value":"localhost"}]}`,
+			contentType: "application/json",
+			expected:    `{"fields":[{"name":"password","value":"********"},{"name":"host","value":"localhost"}]}`,
+		},
+		"nested": {
+			body:        `[{"settings":{"secretToken":"secret","port":8080}}]`,
+			contentType: "application/json",
+			expected:    `[{"settings":{"port":8080,"secretToken":"********"}}]`,
+		},
+		"empty sensitive value": {
+			body:        `{"password":""}`,
+			contentType: "application/json",
+			expected:    `{"password":""}`,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, RedactBody([]byte(test.body), test.contentType))
+		})
+	}
+}
+
+func TestLoggingTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	client := &http.Client{Transport: &LoggingTransport{Transport: http.DefaultTransport, LogBodies: true}}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/api/v3/notification", strings.NewReader(`{"fields":[{"name":"apiKey","value":"secret"}]}`))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Api-Key", "secret")
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+
+	// the response body must still be readable.
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"fields":[{"name":"apiKey","value":"secret"}]}`, string(body))
+
+	logs := output.String()
+	assert.Contains(t, logs, "/api/v3/notification")
+	assert.Contains(t, logs, `"status":200`)
+	assert.Contains(t, logs, "duration")
+	assert.Contains(t, logs, SensitiveValue)
+	assert.NotContains(t, logs, "secret")
+}
+
+func TestLoggingTransportWithoutBodies(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"title":"Movie"}]`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	client := &http.Client{Transport: &LoggingTransport{Transport: http.DefaultTransport}}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v3/movie", nil)
+	assert.NoError(t, err)
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"title":"Movie"}]`, string(body))
+
+	logs := output.String()
+	assert.Contains(t, logs, `"status":200`)
+	assert.NotContains(t, logs, "received Radarr response")
+	assert.NotContains(t, logs, "Movie")
+}
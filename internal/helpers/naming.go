@@ -0,0 +1,549 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	ErrNamingUnknownToken     = errors.New("unknown token")
+	ErrNamingTranslatedTitle  = errors.New("translated titles cannot be rendered")
+	ErrNamingUnbalancedBraces = errors.New("unbalanced braces")
+)
+
+// namingEllipsis marks the truncated titles, it is replaced after the cleanup so that the dots are kept.
+const namingEllipsis = "{ellipsis}"
+
+// namingTokenBody matches the content of a token: prefix, name with optional separator, custom format and suffix.
+const namingTokenBody = `([- ._\[(]*)([a-z0-9]+(?:([- ._]+)[a-z0-9]+)?)(?::([ ,a-z0-9|+-]*[,a-z0-9|+]))?([- ._)\]]*)`
+
+// namingTokenRegexp matches escaped braces, tagged tokens like {imdb-{ImdbId}} and plain tokens, the same way Radarr does.
+var namingTokenRegexp = regexp.MustCompile(`(?i)\{\{|\}\}|\{(imdb|tmdb|edition)-\{` + namingTokenBody + `\}\}|\{` + namingTokenBody + `\}`)
+
+// namingTitleYearRegexp matches a title already ending with its year.
+var namingTitleYearRegexp = regexp.MustCompile(`\(\d{4}\)$`)
+
+// namingTitlePrefixRegexp matches the article moved to the end by the "The" title tokens.
+var namingTitlePrefixRegexp = regexp.MustCompile(`^(The|An|A) (.*?)((?: *\([^)]+\))*)$`)
+
+// namingCleanTitleRegexps match the characters removed by the clean title tokens: punctuation between spaces,
+// quotes and colons ending a word, and brackets.
+var namingCleanTitleRegexps = []*regexp.Regexp{
+	regexp.MustCompile(`(\s)[,<>/\\;:'"|` + "`" + `~!?@$%^*_=-](\s)`),
+	regexp.MustCompile(`['` + "`" + `":?,]((?:s|m|t|ve|ll|d|re)\s|\s|$)`),
+	regexp.MustCompile(`[()\[\]{}]`),
+}
+
+// namingIllegalCharacters maps the characters not allowed in file names to their replacement.
+var namingIllegalCharacters = strings.NewReplacer(`\`, "+", "/", "+", "<", "", ">", "", "?", "!", "*", "-", "|", "", `"`, "")
+
+// namingLanguageCodes maps the ISO 639-2 codes reported by media info to the two letter codes rendered by Radarr.
+var namingLanguageCodes = map[string]string{
+	"ara": "AR", "chi": "ZH", "zho": "ZH", "cze": "CS", "ces": "CS", "dan": "DA", "dut": "NL", "nld": "NL",
+	"eng": "EN", "fin": "FI", "fre": "FR", "fra": "FR", "ger": "DE", "deu": "DE", "gre": "EL", "ell": "EL",
+	"heb": "HE", "hin": "HI", "hun": "HU", "ita": "IT", "jpn": "JA", "kor": "KO", "nor": "NO", "pol": "PL",
+	"por": "PT", "rus": "RU", "spa": "ES", "swe": "SV", "tha": "TH", "tur": "TR", "ukr": "UK", "vie": "VI",
+}
+
+// NamingMovie holds the movie and movie file values rendered by the Radarr naming tokens.
+type NamingMovie struct {
+	Title                 string
+	OriginalTitle         string
+	Collection            string
+	Certification         string
+	IMDBID                string
+	Quality               string
+	ReleaseGroup          string
+	Edition               string
+	SceneName             string
+	OriginalFilename      string
+	VideoCodec            string
+	VideoDynamicRangeType string
+	AudioCodec            string
+	AudioChannels         string
+	CustomFormats         []string
+	AudioLanguages        []string
+	SubtitleLanguages     []string
+	Year                  int64
+	TMDBID                int64
+	VideoBitDepth         int64
+	Proper                bool
+	Repack                bool
+	Is3D                  bool
+}
+
+// namingTokenHandler renders a single token. File tokens are rendered as empty by Radarr in movie folder formats,
+// title tokens are truncated (e.g. {Movie Title:30}) or translated (e.g. {Movie Title:DE}) through the custom format.
+type namingTokenHandler struct {
+	render func(m *NamingMovie, custom string) string
+	file   bool
+	title  bool
+}
+
+// namingToken is a single token parsed from a format.
+type namingToken struct {
+	tag       string
+	prefix    string
+	name      string
+	separator string
+	custom    string
+	suffix    string
+}
+
+var namingTokenHandlers = map[string]namingTokenHandler{
+	"movie title":                     {render: func(m *NamingMovie, _ string) string { return m.Title }, title: true},
+	"movie cleantitle":                {render: func(m *NamingMovie, _ string) string { return namingCleanTitle(m.Title) }, title: true},
+	"movie titlethe":                  {render: func(m *NamingMovie, _ string) string { return namingTitleThe(m.Title) }, title: true},
+	"movie cleantitlethe":             {render: func(m *NamingMovie, _ string) string { return namingCleanTitleThe(m.Title) }, title: true},
+	"movie titleyear":                 {render: func(m *NamingMovie, _ string) string { return namingTitleYear(m.Title, m.Year) }, title: true},
+	"movie cleantitleyear":            {render: func(m *NamingMovie, _ string) string { return namingCleanTitle(namingTitleYear(m.Title, m.Year)) }, title: true},
+	"movie titletheyear":              {render: func(m *NamingMovie, _ string) string { return namingTitleThe(namingTitleYear(m.Title, m.Year)) }, title: true},
+	"movie cleantitletheyear":         {render: func(m *NamingMovie, _ string) string { return namingCleanTitleThe(namingTitleYear(m.Title, m.Year)) }, title: true},
+	"movie originaltitle":             {render: func(m *NamingMovie, _ string) string { return m.OriginalTitle }, title: true},
+	"movie cleanoriginaltitle":        {render: func(m *NamingMovie, _ string) string { return namingCleanTitle(m.OriginalTitle) }, title: true},
+	"movie titlefirstcharacter":       {render: func(m *NamingMovie, _ string) string { return namingFirstCharacter(namingTitleThe(m.Title)) }},
+	"movie collection":                {render: func(m *NamingMovie, _ string) string { return m.Collection }, title: true},
+	"movie certification":             {render: func(m *NamingMovie, _ string) string { return m.Certification }},
+	"release year":                    {render: func(m *NamingMovie, _ string) string { return namingNumber(m.Year) }},
+	"imdbid":                          {render: func(m *NamingMovie, _ string) string { return m.IMDBID }},
+	"tmdbid":                          {render: func(m *NamingMovie, _ string) string { return namingNumber(m.TMDBID) }},
+	"quality full":                    {render: namingQualityFull, file: true},
+	"quality title":                   {render: func(m *NamingMovie, _ string) string { return m.Quality }, file: true},
+	"mediainfo simple":                {render: namingMediaInfoSimple, file: true},
+	"mediainfo full":                  {render: namingMediaInfoFull, file: true},
+	"mediainfo videocodec":            {render: func(m *NamingMovie, _ string) string { return m.VideoCodec }, file: true},
+	"mediainfo videobitdepth":         {render: func(m *NamingMovie, _ string) string { return namingNumber(m.VideoBitDepth) }, file: true},
+	"mediainfo videodynamicrange":     {render: namingDynamicRange, file: true},
+	"mediainfo videodynamicrangetype": {render: func(m *NamingMovie, _ string) string { return m.VideoDynamicRangeType }, file: true},
+	"mediainfo audiocodec":            {render: func(m *NamingMovie, _ string) string { return m.AudioCodec }, file: true},
+	"mediainfo audiochannels":         {render: func(m *NamingMovie, _ string) string { return m.AudioChannels }, file: true},
+	"mediainfo audiolanguages":        {render: func(m *NamingMovie, custom string) string { return namingLanguages(m.AudioLanguages, custom, true) }, file: true},
+	"mediainfo audiolanguagesall":     {render: func(m *NamingMovie, custom string) string { return namingLanguages(m.AudioLanguages, custom, false) }, file: true},
+	"mediainfo subtitlelanguages":     {render: func(m *NamingMovie, custom string) string { return namingLanguages(m.SubtitleLanguages, custom, false) }, file: true},
+	"mediainfo 3d":                    {render: naming3D, file: true},
+	"release group":                   {render: func(m *NamingMovie, _ string) string { return m.ReleaseGroup }, file: true},
+	"edition tags":                    {render: func(m *NamingMovie, _ string) string { return namingEdition(m.Edition) }, file: true},
+	"custom formats":                  {render: func(m *NamingMovie, _ string) string { return strings.Join(m.CustomFormats, " ") }, file: true},
+	"custom format":                   {render: namingCustomFormat, file: true},
+	"original title":                  {render: func(m *NamingMovie, _ string) string { return m.SceneName }, file: true},
+	"original filename":               {render: func(m *NamingMovie, _ string) string { return m.OriginalFilename }, file: true},
+}
+
+// RenderMovieName renders a Radarr movie file or folder format for the given movie.
+// Values are cleaned like Radarr does with the default settings: illegal characters are replaced and colons use the smart replacement.
+// Translated titles (e.g. {Movie Title:DE}) need the Radarr translations, so they return an error.
+func RenderMovieName(format string, movie *NamingMovie) (string, error) {
+	return walkNamingFormat(format, func(token namingToken) (string, error) {
+		return token.render(movie)
+	})
+}
+
+// ValidateNamingFormat checks that the format has balanced braces and only known tokens.
+func ValidateNamingFormat(format string) error {
+	_, err := walkNamingFormat(format, func(token namingToken) (string, error) {
+		_, err := token.handler()
+
+		return "", err
+	})
+
+	return err
+}
+
+// NamingFileTokens returns the file tokens of the format, Radarr renders them as empty in movie folder formats.
+func NamingFileTokens(format string) []string {
+	var tokens []string
+
+	_, _ = walkNamingFormat(format, func(token namingToken) (string, error) {
+		if handler, err := token.handler(); err == nil && handler.file {
+			tokens = append(tokens, "{"+token.name+"}")
+		}
+
+		return "", nil
+	})
+
+	return tokens
+}
+
+// walkNamingFormat renders each token of the format with the given function, checking the braces around them.
+func walkNamingFormat(format string, render func(token namingToken) (string, error)) (string, error) {
+	var name strings.Builder
+
+	last := 0
+
+	for _, match := range namingTokenRegexp.FindAllStringSubmatchIndex(format, -1) {
+		if err := checkNamingLiteral(format[last:match[0]]); err != nil {
+			return "", err
+		}
+
+		name.WriteString(format[last:match[0]])
+		last = match[1]
+
+		// escaped braces are rendered as a single brace.
+		if text := format[match[0]:match[1]]; text == "{{" || text == "}}" {
+			name.WriteString(text[:1])
+
+			continue
+		}
+
+		value, err := render(parseNamingToken(format, match))
+		if err != nil {
+			return "", err
+		}
+
+		name.WriteString(value)
+	}
+
+	if err := checkNamingLiteral(format[last:]); err != nil {
+		return "", err
+	}
+
+	name.WriteString(format[last:])
+
+	return strings.ReplaceAll(cleanupNamingName(name.String()), namingEllipsis, "..."), nil
+}
+
+// parseNamingToken reads the token groups of either the tagged or the plain alternative.
+func parseNamingToken(format string, match []int) namingToken {
+	group := func(index int) string {
+		if match[2*index] < 0 {
+			return ""
+		}
+
+		return format[match[2*index]:match[2*index+1]]
+	}
+
+	// groups 2 to 6 belong to the tagged alternative, 7 to 11 to the plain one.
+	offset := 7
+	if match[2] >= 0 {
+		offset = 2
+	}
+
+	return namingToken{
+		tag:       group(1),
+		prefix:    group(offset),
+		name:      group(offset + 1),
+		separator: group(offset + 2),
+		custom:    group(offset + 3),
+		suffix:    group(offset + 4),
+	}
+}
+
+func (t namingToken) handler() (namingTokenHandler, error) {
+	key := strings.ToLower(t.name)
+	if t.separator != "" {
+		key = strings.Replace(key, strings.ToLower(t.separator), " ", 1)
+	}
+
+	handler, ok := namingTokenHandlers[key]
+	if !ok {
+		return handler, fmt.Errorf("%w: {%s}", ErrNamingUnknownToken, t.name)
+	}
+
+	return handler, nil
+}
+
+func (t namingToken) render(movie *NamingMovie) (string, error) {
+	handler, err := t.handler()
+	if err != nil {
+		return "", err
+	}
+
+	value := handler.render(movie, t.custom)
+
+	if handler.title && t.custom != "" {
+		length, err := strconv.Atoi(t.custom)
+		if err != nil {
+			return "", fmt.Errorf("%w: {%s:%s}", ErrNamingTranslatedTitle, t.name, t.custom)
+		}
+
+		value = namingTruncate(value, length)
+	}
+
+	value = strings.TrimSpace(value)
+
+	switch {
+	case !strings.ContainsFunc(t.name, unicode.IsUpper):
+		value = strings.ToLower(value)
+	case !strings.ContainsFunc(t.name, unicode.IsLower):
+		value = strings.ToUpper(value)
+	}
+
+	if strings.TrimSpace(t.separator) != "" {
+		value = strings.ReplaceAll(value, " ", t.separator)
+	}
+
+	value = namingCleanFileName(value)
+	if value == "" {
+		return "", nil
+	}
+
+	value = t.prefix + value + t.suffix
+	if t.tag != "" {
+		value = "{" + t.tag + "-" + value + "}"
+	}
+
+	return value, nil
+}
+
+func checkNamingLiteral(literal string) error {
+	if strings.ContainsAny(literal, "{}") {
+		return fmt.Errorf("%w near %q", ErrNamingUnbalancedBraces, literal)
+	}
+
+	return nil
+}
+
+// namingCleanFileName replaces the characters not allowed in file names, colons use the smart replacement.
+func namingCleanFileName(value string) string {
+	value = strings.ReplaceAll(value, ": ", " - ")
+	value = strings.ReplaceAll(value, ":", "-")
+
+	return namingIllegalCharacters.Replace(value)
+}
+
+// cleanupNamingName collapses repeated separators left by empty tokens and trims the result.
+func cleanupNamingName(name string) string {
+	var result strings.Builder
+
+	var previous rune
+
+	for _, r := range name {
+		if r == previous && strings.ContainsRune("- ._", r) {
+			continue
+		}
+
+		result.WriteRune(r)
+		previous = r
+	}
+
+	return strings.TrimRight(strings.TrimSpace(result.String()), "- ._")
+}
+
+func namingNumber(value int64) string {
+	if value == 0 {
+		return ""
+	}
+
+	return strconv.FormatInt(value, 10)
+}
+
+func namingCleanTitle(title string) string {
+	title = strings.ReplaceAll(title, "&", "and")
+	title = strings.NewReplacer("/", " ", `\`, " ").Replace(title)
+
+	for _, cleanup := range namingCleanTitleRegexps {
+		title = cleanup.ReplaceAllString(title, "$1$2")
+	}
+
+	// remove diacritics, decomposing the characters and dropping the combining marks.
+	title = strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+
+		return r
+	}, norm.NFD.String(title))
+
+	return strings.Join(strings.Fields(title), " ")
+}
+
+// namingTruncate cuts the value to the given number of bytes, ellipsis included.
+// A negative length keeps the end of the value instead of the beginning.
+func namingTruncate(value string, length int) string {
+	limit := max(length, -length)
+	if length == 0 || len(value) <= limit {
+		return value
+	}
+
+	// the ellipsis takes three bytes.
+	limit = max(limit-3, 0)
+
+	if length < 0 {
+		start := len(value) - limit
+		for start < len(value) && !utf8.RuneStart(value[start]) {
+			start++
+		}
+
+		return namingEllipsis + strings.TrimLeft(value[start:], " .")
+	}
+
+	for limit > 0 && !utf8.RuneStart(value[limit]) {
+		limit--
+	}
+
+	return strings.TrimRight(value[:limit], " .") + namingEllipsis
+}
+
+// namingTitleYear appends the year to the title, unless it is unknown or already there.
+func namingTitleYear(title string, year int64) string {
+	if year == 0 || namingTitleYearRegexp.MatchString(title) {
+		return title
+	}
+
+	return title + " (" + strconv.FormatInt(year, 10) + ")"
+}
+
+func namingTitleThe(title string) string {
+	return namingTitlePrefixRegexp.ReplaceAllString(title, "$2, $1$3")
+}
+
+func namingCleanTitleThe(title string) string {
+	match := namingTitlePrefixRegexp.FindStringSubmatch(title)
+	if match == nil {
+		return namingCleanTitle(title)
+	}
+
+	title = namingCleanTitle(match[2]) + ", " + match[1]
+	if suffix := namingCleanTitle(match[3]); suffix != "" {
+		title += " " + suffix
+	}
+
+	return title
+}
+
+func namingFirstCharacter(title string) string {
+	for _, r := range []rune(title)[:min(2, len([]rune(title)))] {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return strings.ToUpper(string(r))
+		}
+	}
+
+	if title == "" {
+		return ""
+	}
+
+	return "_"
+}
+
+func namingQualityFull(m *NamingMovie, _ string) string {
+	switch {
+	case m.Quality == "":
+		return ""
+	case m.Repack:
+		return m.Quality + " Repack"
+	case m.Proper:
+		return m.Quality + " Proper"
+	default:
+		return m.Quality
+	}
+}
+
+func namingDynamicRange(m *NamingMovie, _ string) string {
+	if m.VideoDynamicRangeType == "" {
+		return ""
+	}
+
+	return "HDR"
+}
+
+func naming3D(m *NamingMovie, _ string) string {
+	if !m.Is3D {
+		return ""
+	}
+
+	return "3D"
+}
+
+func namingMediaInfoSimple(m *NamingMovie, _ string) string {
+	return m.VideoCodec + " " + m.AudioCodec
+}
+
+func namingMediaInfoFull(m *NamingMovie, _ string) string {
+	return m.VideoCodec + " " + m.AudioCodec + namingLanguages(m.AudioLanguages, "", true) + " " + namingLanguages(m.SubtitleLanguages, "", false)
+}
+
+// namingLanguages renders languages as [EN+DE]. The custom format keeps only the given languages, or drops them when prefixed by a dash.
+// English only audio is not rendered unless explicitly requested.
+func namingLanguages(languages []string, custom string, audio bool) string {
+	exclude := strings.HasPrefix(custom, "-")
+	filter := strings.FieldsFunc(strings.ToUpper(strings.TrimPrefix(custom, "-")), func(r rune) bool { return r == '+' })
+
+	codes := make([]string, 0, len(languages))
+
+	for _, language := range languages {
+		code := strings.ToUpper(language)
+		if mapped, ok := namingLanguageCodes[strings.ToLower(language)]; ok {
+			code = mapped
+		}
+
+		if code == "" || slices.Contains(codes, code) || (len(filter) > 0 && slices.Contains(filter, code) == exclude) {
+			continue
+		}
+
+		codes = append(codes, code)
+	}
+
+	if len(codes) == 0 || (audio && custom == "" && len(codes) == 1 && codes[0] == "EN") {
+		return ""
+	}
+
+	return "[" + strings.Join(codes, "+") + "]"
+}
+
+func namingEdition(edition string) string {
+	words := strings.Fields(edition)
+	for i, word := range words {
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		words[i] = string(runes)
+	}
+
+	return strings.Join(words, " ")
+}
+
+func namingCustomFormat(m *NamingMovie, custom string) string {
+	for _, format := range m.CustomFormats {
+		if strings.EqualFold(format, custom) {
+			return format
+		}
+	}
+
+	return ""
+}
+
+// NamingFormatValidator validates a Radarr naming format at plan time.
+func NamingFormatValidator(folder bool) validator.String {
+	return namingFormatValidator{folder: folder}
+}
+
+type namingFormatValidator struct {
+	folder bool
+}
+
+func (v namingFormatValidator) Description(_ context.Context) string {
+	if v.folder {
+		return "must be a valid movie folder format"
+	}
+
+	return "must be a valid movie format"
+}
+
+func (v namingFormatValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v namingFormatValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := ValidateNamingFormat(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, ValidationError, fmt.Sprintf("Attribute %s %s, got error: %s", req.Path, v.Description(ctx), err))
+
+		return
+	}
+
+	if tokens := NamingFileTokens(req.ConfigValue.ValueString()); v.folder && len(tokens) > 0 {
+		resp.Diagnostics.AddAttributeWarning(req.Path, ValidationWarning, fmt.Sprintf("Attribute %s uses the file tokens %s, Radarr renders them as empty in movie folder formats.", req.Path, strings.Join(tokens, ", ")))
+	}
+}
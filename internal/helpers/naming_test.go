@@ -0,0 +1,223 @@
+package helpers
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// namingSampleMovie is the sample movie used by Radarr to render the naming examples.
+var namingSampleMovie = NamingMovie{
+	CustomFormats:         []string{"IMAX", "HYBRID"},
+	AudioLanguages:        []string{"ger"},
+	SubtitleLanguages:     []string{"eng", "ger"},
+	Title:                 "The Movie: Title",
+	OriginalTitle:         "The Original Movie Title",
+	Collection:            "The Movie Collection",
+	Certification:         "R",
+	IMDBID:                "tt0066921",
+	Quality:               "Bluray-1080p",
+	ReleaseGroup:          "EVOLVE",
+	Edition:               "Ultimate extended edition",
+	SceneName:             "The.Movie.Title.2010.1080p.BluRay.DTS.x264-EVOLVE",
+	OriginalFilename:      "The.Movie.Title.2010.1080p.BluRay.DTS.x264-EVOLVE",
+	VideoCodec:            "x264",
+	VideoDynamicRangeType: "DV HDR10",
+	AudioCodec:            "DTS",
+	AudioChannels:         "5.1",
+	Year:                  2010,
+	TMDBID:                345691,
+	VideoBitDepth:         10,
+	Proper:                true,
+	Is3D:                  true,
+}
+
+// namingLibraryMovie is a movie without file.
+var namingLibraryMovie = NamingMovie{
+	Title:         "Amélie",
+	OriginalTitle: "Le Fabuleux Destin d'Amélie Poulain",
+	Year:          2001,
+	TMDBID:        194,
+}
+
+func TestRenderMovieName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		format   string
+		expected string
+		movie    NamingMovie
+	}{
+		"default": {
+			movie:    namingSampleMovie,
+			format:   "{Movie Title} ({Release Year}) {Quality Full}",
+			expected: "The Movie - Title (2010) Bluray-1080p Proper",
+		},
+		"plex": {
+			movie:    namingSampleMovie,
+			format:   "{Movie CleanTitle} {(Release Year)} {imdb-{ImdbId}} {edition-{Edition Tags}} {[Custom Formats]}{[Quality Full]}{[MediaInfo 3D]}{[MediaInfo VideoDynamicRangeType]}{[MediaInfo AudioCodec}{ MediaInfo AudioChannels]}{MediaInfo AudioLanguages}{[MediaInfo VideoCodec]}{-Release Group}",
+			expected: "The Movie Title (2010) {imdb-tt0066921} {edition-Ultimate Extended Edition} [IMAX HYBRID][Bluray-1080p Proper][3D][DV HDR10][DTS 5.1][DE][x264]-EVOLVE",
+		},
+		"separator": {
+			movie:    namingSampleMovie,
+			format:   "{Movie.CleanTitle}.{Release.Year}.{Quality.Title}",
+			expected: "The.Movie.Title.2010.Bluray-1080p",
+		},
+		"case": {
+			movie:    namingSampleMovie,
+			format:   "{movie cleantitle} {MEDIAINFO VIDEOCODEC}",
+			expected: "the movie title X264",
+		},
+		"the": {
+			movie:    namingSampleMovie,
+			format:   "{Movie TitleFirstCharacter}/{Movie TitleThe}/{Movie CleanTitleThe}",
+			expected: "M/Movie - Title, The/Movie Title, The",
+		},
+		"media info": {
+			movie:    namingSampleMovie,
+			format:   "{MediaInfo Simple} - {MediaInfo Full} - {MediaInfo VideoDynamicRange} {MediaInfo VideoBitDepth}bit",
+			expected: "x264 DTS - x264 DTS[DE] [EN+DE] - HDR 10bit",
+		},
+		"language filter": {
+			movie:    namingSampleMovie,
+			format:   "{MediaInfo SubtitleLanguages:DE} {MediaInfo SubtitleLanguages:-DE}",
+			expected: "[DE] [EN]",
+		},
+		"custom format": {
+			movie:    namingSampleMovie,
+			format:   "{Movie Title} {[Custom Format:imax]}{[Custom Format:Remux]}",
+			expected: "The Movie - Title [IMAX]",
+		},
+		"escaped braces": {
+			movie:    namingSampleMovie,
+			format:   "{{{TmdbId}}} {Movie Collection}",
+			expected: "{345691} The Movie Collection",
+		},
+		"original": {
+			movie:    namingSampleMovie,
+			format:   "{Original Title}",
+			expected: "The.Movie.Title.2010.1080p.BluRay.DTS.x264-EVOLVE",
+		},
+		"missing file": {
+			movie:    namingLibraryMovie,
+			format:   "{Movie CleanTitle} ({Release Year}) {imdb-{ImdbId}} {[Quality Full]}{-Release Group}",
+			expected: "Amelie (2001)",
+		},
+		"contraction": {
+			movie:    NamingMovie{Title: "Don't Look Up", Year: 2021},
+			format:   "{Movie CleanTitle} {Movie TitleFirstCharacter}",
+			expected: "Dont Look Up D",
+		},
+		"original title": {
+			movie:    namingLibraryMovie,
+			format:   "{Movie CleanOriginalTitle} {tmdb-{TmdbId}}",
+			expected: "Le Fabuleux Destin d'Amelie Poulain {tmdb-194}",
+		},
+		"title year": {
+			movie:    namingSampleMovie,
+			format:   "{Movie TitleYear}/{Movie CleanTitleYear}/{Movie TitleTheYear}/{Movie CleanTitleTheYear}",
+			expected: "The Movie - Title (2010)/The Movie Title 2010/Movie - Title, The (2010)/Movie Title, The 2010",
+		},
+		"truncate": {
+			movie:    namingLibraryMovie,
+			format:   "{Movie OriginalTitle:20} - {Movie OriginalTitle:-15} - {Movie Title:30}",
+			expected: "Le Fabuleux Desti... - ...lie Poulain - Amélie",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := RenderMovieName(test.format, &test.movie)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestRenderMovieNameTranslatedTitle(t *testing.T) {
+	t.Parallel()
+
+	_, err := RenderMovieName("{Movie Title:DE} ({Release Year})", &namingSampleMovie)
+	assert.ErrorIs(t, err, ErrNamingTranslatedTitle)
+}
+
+func TestValidateNamingFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err    error
+		format string
+	}{
+		"file": {
+			format: "{Movie CleanTitle} {(Release Year)} {imdb-{ImdbId}} {edition-{Edition Tags}} {[Custom Formats]}{[Quality Full]}{-Release Group}",
+		},
+		"folder": {
+			format: "{Movie TitleFirstCharacter}/{Movie Title} ({Release Year}) {tmdb-{TmdbId}}",
+		},
+		"translated title": {
+			format: "{Movie Title:DE|FR} {Movie CleanTitle:-30}",
+		},
+		"unknown token": {
+			format: "{Movie Titel} ({Release Year})",
+			err:    ErrNamingUnknownToken,
+		},
+		"unclosed": {
+			format: "{Movie Title} ({Release Year)",
+			err:    ErrNamingUnbalancedBraces,
+		},
+		"unopened": {
+			format: "{Movie Title} Release Year}",
+			err:    ErrNamingUnbalancedBraces,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ErrorIs(t, ValidateNamingFormat(test.format), test.err)
+		})
+	}
+}
+
+func TestNamingTokens(t *testing.T) {
+	t.Parallel()
+
+	// tokens listed by Radarr in the naming settings.
+	radarrTokens := []string{
+		"{Movie Title}", "{Movie Title:DE}", "{Movie CleanTitle}", "{Movie CleanTitle:DE}", "{Movie TitleThe}", "{Movie CleanTitleThe}",
+		"{Movie TitleYear}", "{Movie CleanTitleYear}", "{Movie TitleTheYear}", "{Movie CleanTitleTheYear}", "{Movie TitleFirstCharacter}",
+		"{Movie OriginalTitle}", "{Movie CleanOriginalTitle}", "{Movie Collection}", "{Movie Certification}", "{Release Year}",
+		"{ImdbId}", "{TmdbId}",
+		"{Quality Full}", "{Quality Title}",
+		"{MediaInfo Simple}", "{MediaInfo Full}", "{MediaInfo AudioCodec}", "{MediaInfo AudioChannels}", "{MediaInfo AudioLanguages}",
+		"{MediaInfo AudioLanguagesAll}", "{MediaInfo SubtitleLanguages}", "{MediaInfo VideoCodec}", "{MediaInfo VideoBitDepth}",
+		"{MediaInfo VideoDynamicRange}", "{MediaInfo VideoDynamicRangeType}", "{MediaInfo 3D}",
+		"{Release Group}", "{Edition Tags}", "{Custom Formats}", "{Custom Format:FormatName}",
+		"{Original Title}", "{Original Filename}",
+	}
+
+	for _, token := range radarrTokens {
+		assert.NoError(t, ValidateNamingFormat(token), token)
+	}
+
+	// every handled token must be a Radarr one.
+	for key := range namingTokenHandlers {
+		assert.True(t, slices.ContainsFunc(radarrTokens, func(token string) bool {
+			return strings.EqualFold(strings.SplitN(strings.Trim(token, "{}"), ":", 2)[0], key)
+		}), key)
+	}
+}
+
+func TestNamingFileTokens(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, NamingFileTokens("{Movie TitleFirstCharacter}/{Movie Title} ({Release Year}) {tmdb-{TmdbId}}"))
+	assert.Equal(t, []string{"{Quality Full}", "{MediaInfo.VideoCodec}"}, NamingFileTokens("{Movie Title} {[Quality Full]} {MediaInfo.VideoCodec}"))
+}
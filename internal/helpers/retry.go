@@ -0,0 +1,167 @@
+package helpers
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// databaseLockedError is the message returned by Radarr when SQLite is busy.
+const databaseLockedError = "database is locked"
+
+// RetryTransport is a http.RoundTripper retrying transient failures with exponential backoff.
+type RetryTransport struct {
+	Transport  http.RoundTripper
+	MinWait    time.Duration
+	MaxWait    time.Duration
+	MaxRetries int
+}
+
+// RoundTrip executes a single HTTP transaction, retrying it when the failure is transient.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		attemptReq, err := rewindRequest(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.transport().RoundTrip(attemptReq)
+
+		reason := retryReason(req, resp, err)
+		if reason == "" || attempt >= t.MaxRetries {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+
+		tflog.Warn(req.Context(), "retrying Radarr request", map[string]interface{}{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+			"reason":  reason,
+		})
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *RetryTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+
+	return http.DefaultTransport
+}
+
+// backoff calculates the exponential wait time for a given attempt, honoring the Retry-After header.
+func (t *RetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, t.MaxWait)
+		}
+	}
+
+	wait := t.MinWait << attempt
+	if wait <= 0 || wait > t.MaxWait {
+		return t.MaxWait
+	}
+
+	return wait
+}
+
+// rewindRequest returns a request with a fresh body to be sent for the given attempt.
+func rewindRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil || req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	newReq := req.Clone(req.Context())
+	newReq.Body = body
+
+	return newReq, nil
+}
+
+// retryReason returns why the request should be retried, empty if it should not.
+func retryReason(req *http.Request, resp *http.Response, err error) string {
+	if err != nil {
+		if req.Context().Err() != nil {
+			return ""
+		}
+
+		// connection failures are always safe to retry since the request never reached Radarr.
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return err.Error()
+		}
+
+		if isIdempotent(req.Method) {
+			return err.Error()
+		}
+
+		return ""
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return resp.Status
+	case http.StatusNotImplemented:
+		return ""
+	}
+
+	if resp.StatusCode < http.StatusInternalServerError {
+		return ""
+	}
+
+	if isDatabaseLocked(resp) || isIdempotent(req.Method) {
+		return resp.Status
+	}
+
+	return ""
+}
+
+// isDatabaseLocked checks the response body for SQLite lock errors, leaving the body readable.
+func isDatabaseLocked(resp *http.Response) bool {
+	if resp.Body == nil {
+		return false
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return err == nil && strings.Contains(strings.ToLower(string(body)), databaseLockedError)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,122 @@
+package helpers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryTransport(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method   string
+		body     string
+		statuses []int
+		expected int
+		calls    int32
+	}{
+		"success": {
+			method:   http.MethodGet,
+			statuses: []int{http.StatusOK},
+			expected: http.StatusOK,
+			calls:    1,
+		},
+		"unavailable": {
+			method:   http.MethodPost,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusCreated},
+			expected: http.StatusCreated,
+			calls:    3,
+		},
+		"idempotent server error": {
+			method:   http.MethodPut,
+			statuses: []int{http.StatusInternalServerError, http.StatusAccepted},
+			expected: http.StatusAccepted,
+			calls:    2,
+		},
+		"non idempotent server error": {
+			method:   http.MethodPost,
+			statuses: []int{http.StatusInternalServerError, http.StatusCreated},
+			expected: http.StatusInternalServerError,
+			calls:    1,
+		},
+		"database locked": {
+			method:   http.MethodPost,
+			body:     "database is locked",
+			statuses: []int{http.StatusInternalServerError, http.StatusCreated},
+			expected: http.StatusCreated,
+			calls:    2,
+		},
+		"client error": {
+			method:   http.MethodGet,
+			statuses: []int{http.StatusBadRequest, http.StatusOK},
+			expected: http.StatusBadRequest,
+			calls:    1,
+		},
+		"max retries": {
+			method:   http.MethodGet,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			expected: http.StatusServiceUnavailable,
+			calls:    3,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				call := atomic.AddInt32(&calls, 1)
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, "payload", string(body))
+
+				w.WriteHeader(test.statuses[call-1])
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: &RetryTransport{
+				MaxRetries: 2,
+				MinWait:    time.Millisecond,
+				MaxWait:    10 * time.Millisecond,
+			}}
+
+			req, _ := http.NewRequest(test.method, server.URL, strings.NewReader("payload"))
+			resp, err := client.Do(req)
+			assert.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			body, _ := io.ReadAll(resp.Body)
+			assert.Equal(t, test.expected, resp.StatusCode)
+			assert.Equal(t, test.body, string(body))
+			assert.Equal(t, test.calls, atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	t.Parallel()
+
+	transport := &RetryTransport{
+		MinWait: time.Second,
+		MaxWait: 10 * time.Second,
+	}
+
+	retryAfter := &http.Response{Header: http.Header{}}
+	retryAfter.Header.Set("Retry-After", "3")
+
+	assert.Equal(t, time.Second, transport.backoff(0, nil))
+	assert.Equal(t, 4*time.Second, transport.backoff(2, nil))
+	assert.Equal(t, 10*time.Second, transport.backoff(5, nil))
+	assert.Equal(t, 3*time.Second, transport.backoff(0, retryAfter))
+}
@@ -0,0 +1,89 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var ErrNotReady = errors.New("radarr is not ready")
+
+// StartupTransport is a http.RoundTripper waiting for Radarr before sending the first request.
+// The check is polled once for all the requests until Radarr is ready, no check is done when Timeout is not set.
+type StartupTransport struct {
+	Transport http.RoundTripper
+	// Check returns nil once Radarr answers correctly.
+	Check func(ctx context.Context) error
+	// err is the outcome of the wait, kept for the whole run so that later requests do not wait again.
+	err      error
+	done     chan struct{}
+	Timeout  time.Duration
+	Interval time.Duration
+	once     sync.Once
+}
+
+// RoundTrip waits for Radarr to be ready and then executes a single HTTP transaction.
+func (t *StartupTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	return t.Transport.RoundTrip(req)
+}
+
+// wait starts polling Radarr on the first request, every request waits for the same outcome.
+func (t *StartupTransport) wait(ctx context.Context) error {
+	if t.Timeout <= 0 {
+		return nil
+	}
+
+	t.once.Do(func() {
+		t.done = make(chan struct{})
+
+		// the polling outlives the first request, it is bound by Timeout only.
+		go func() {
+			defer close(t.done)
+
+			t.err = t.poll(context.WithoutCancel(ctx))
+		}()
+	})
+
+	select {
+	case <-t.done:
+		return t.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// poll checks Radarr until it is ready, the version is unsupported or the timeout expires.
+func (t *StartupTransport) poll(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, t.Timeout)
+	defer cancel()
+
+	for attempt := 1; ; attempt++ {
+		err := t.Check(ctx)
+		if err == nil || errors.Is(err, ErrUnsupportedVersion) {
+			return err
+		}
+
+		tflog.Debug(ctx, "waiting for Radarr to be ready", map[string]interface{}{
+			"attempt": attempt,
+			"error":   err.Error(),
+		})
+
+		timer := time.NewTimer(t.Interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return fmt.Errorf("%w after %s, last error: %w", ErrNotReady, t.Timeout, err)
+		case <-timer.C:
+		}
+	}
+}
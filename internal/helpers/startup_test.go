@@ -0,0 +1,164 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errStarting = errors.New("starting")
+
+const neverReady = 1000
+
+func TestStartupTransport(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err        error
+		checkErr   error
+		timeout    time.Duration
+		readyAfter int32
+		checks     int32
+	}{
+		"no wait": {
+			readyAfter: neverReady,
+			timeout:    0,
+		},
+		"ready": {
+			readyAfter: 1,
+			timeout:    time.Second,
+			checks:     1,
+		},
+		"eventually ready": {
+			readyAfter: 3,
+			timeout:    time.Second,
+			checks:     3,
+		},
+		"timeout": {
+			readyAfter: neverReady,
+			timeout:    50 * time.Millisecond,
+			err:        ErrNotReady,
+		},
+		"unsupported version": {
+			readyAfter: neverReady,
+			timeout:    time.Second,
+			checkErr:   ErrUnsupportedVersion,
+			checks:     1,
+			err:        ErrUnsupportedVersion,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			var checks int32
+
+			client := &http.Client{Transport: &StartupTransport{
+				Transport: http.DefaultTransport,
+				Check: func(_ context.Context) error {
+					if atomic.AddInt32(&checks, 1) < test.readyAfter {
+						if test.checkErr != nil {
+							return test.checkErr
+						}
+
+						return errStarting
+					}
+
+					return nil
+				},
+				Timeout:  test.timeout,
+				Interval: time.Millisecond,
+			}}
+
+			for range 2 {
+				resp, err := client.Get(server.URL)
+				if test.err != nil {
+					assert.ErrorIs(t, err, test.err)
+
+					continue
+				}
+
+				assert.NoError(t, err)
+				resp.Body.Close()
+			}
+
+			if test.err == nil || test.checks > 0 {
+				assert.Equal(t, test.checks, atomic.LoadInt32(&checks))
+			}
+		})
+	}
+}
+
+func TestStartupTransportConcurrent(t *testing.T) {
+	t.Parallel()
+
+	// Radarr never comes up.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	var polling int32
+
+	timeout := 200 * time.Millisecond
+	client := &http.Client{Transport: &StartupTransport{
+		Transport: http.DefaultTransport,
+		Check: func(ctx context.Context) error {
+			assert.LessOrEqual(t, atomic.AddInt32(&polling, 1), int32(1), "checks must not run concurrently")
+			defer atomic.AddInt32(&polling, -1)
+
+			req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return err
+			}
+
+			resp.Body.Close()
+
+			return errStarting
+		},
+		Timeout:  timeout,
+		Interval: 10 * time.Millisecond,
+	}}
+
+	start := time.Now()
+	errs := make(chan error, 5)
+
+	for range cap(errs) {
+		go func() {
+			resp, err := client.Get(server.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+
+			errs <- err
+		}()
+	}
+
+	for range cap(errs) {
+		assert.ErrorIs(t, <-errs, ErrNotReady)
+	}
+
+	// all the requests share a single wait.
+	assert.Less(t, time.Since(start), 2*timeout)
+
+	// later requests fail at once.
+	start = time.Now()
+	_, err := client.Get(server.URL)
+	assert.ErrorIs(t, err, ErrNotReady)
+	assert.Less(t, time.Since(start), timeout)
+}
@@ -2,11 +2,16 @@ package helpers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // ImportStatePassthroughIntID is a helper function to set the import
@@ -32,3 +37,107 @@ func ImportStatePassthroughIntID(ctx context.Context, attrPath path.Path, req re
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
 }
+
+// ErrImportNotFound is returned by import lookups when no object matches the identifier.
+var ErrImportNotFound = errors.New("no matching object found")
+
+// ImportLookup resolves a human identifier into the object ID.
+type ImportLookup func(value string) (int, error)
+
+// ImportStateLookupIntID extends ImportStatePassthroughIntID accepting also
+// identifiers starting with one of the lookup prefixes (e.g. `name=`), which
+// are resolved to the ID through the corresponding lookup.
+func ImportStateLookupIntID(ctx context.Context, attrPath path.Path, lookups map[string]ImportLookup, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	for prefix, lookup := range lookups {
+		value, found := strings.CutPrefix(req.ID, prefix)
+		if !found {
+			continue
+		}
+
+		id, err := lookup(value)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				UnexpectedImportIdentifier,
+				fmt.Sprintf("Unable to resolve import identifier %s, got error: %s", req.ID, err),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
+
+		return
+	}
+
+	if _, err := strconv.Atoi(req.ID); err != nil {
+		formats := []string{"ID"}
+		for prefix := range lookups {
+			formats = append(formats, prefix+"VALUE")
+		}
+
+		slices.Sort(formats[1:])
+		resp.Diagnostics.AddError(
+			UnexpectedImportIdentifier,
+			fmt.Sprintf("Expected import identifier with format: %s. Got: %s", strings.Join(formats, " or "), req.ID),
+		)
+
+		return
+	}
+
+	ImportStatePassthroughIntID(ctx, attrPath, req, resp)
+}
+
+// identifiable is implemented by the API resources with an ID.
+type identifiable[T any] interface {
+	*T
+	GetId() int32
+}
+
+// implemented is implemented by the API resources with an implementation.
+type implemented[T any] interface {
+	*T
+	GetImplementation() string
+}
+
+// FilterImplementation returns the items of the given implementation, all of them if it is empty.
+func FilterImplementation[T any, P implemented[T]](items []T, implementation string) []T {
+	if implementation == "" {
+		return items
+	}
+
+	filtered := make([]T, 0, len(items))
+
+	for i := range items {
+		if P(&items[i]).GetImplementation() == implementation {
+			filtered = append(filtered, items[i])
+		}
+	}
+
+	return filtered
+}
+
+// FindImportID returns the ID of the first item whose key matches the value.
+func FindImportID[T any, P identifiable[T]](items []T, value string, key func(P) string) (int, error) {
+	for i := range items {
+		item := P(&items[i])
+		if key(item) == value {
+			return int(item.GetId()), nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s", ErrImportNotFound, value)
+}
+
+// RemoveNotFoundResource removes the resource from state when the API
+// reports that it does not exist anymore, so that terraform can plan its
+// recreation. It returns true if the resource has been removed.
+func RemoveNotFoundResource(ctx context.Context, name string, httpResp *http.Response, err error, resp *resource.ReadResponse) bool {
+	if !IsNotFound(httpResp, err) {
+		return false
+	}
+
+	tflog.Warn(ctx, name+" not found, removing it from state")
+	resp.State.RemoveResource(ctx)
+
+	return true
+}
@@ -0,0 +1,118 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+var errLookup = errors.New("lookup error")
+
+func TestFindImportID(t *testing.T) {
+	t.Parallel()
+
+	tags := []radarr.TagResource{
+		{Id: radarr.PtrInt32(1), Label: *radarr.NewNullableString(radarr.PtrString("hd"))},
+		{Id: radarr.PtrInt32(2), Label: *radarr.NewNullableString(radarr.PtrString("4k"))},
+	}
+
+	id, err := FindImportID(tags, "4k", (*radarr.TagResource).GetLabel)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, id)
+
+	_, err = FindImportID(tags, "sd", (*radarr.TagResource).GetLabel)
+	assert.ErrorIs(t, err, ErrImportNotFound)
+}
+
+func TestFilterImplementation(t *testing.T) {
+	t.Parallel()
+
+	notifications := []radarr.NotificationResource{
+		{Id: radarr.PtrInt32(1), Name: *radarr.NewNullableString(radarr.PtrString("alerts")), Implementation: *radarr.NewNullableString(radarr.PtrString("Webhook"))},
+		{Id: radarr.PtrInt32(2), Name: *radarr.NewNullableString(radarr.PtrString("alerts")), Implementation: *radarr.NewNullableString(radarr.PtrString("Discord"))},
+	}
+
+	id, err := FindImportID(FilterImplementation(notifications, "Discord"), "alerts", (*radarr.NotificationResource).GetName)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, id)
+
+	_, err = FindImportID(FilterImplementation(notifications, "Slack"), "alerts", (*radarr.NotificationResource).GetName)
+	assert.ErrorIs(t, err, ErrImportNotFound)
+
+	assert.Len(t, FilterImplementation(notifications, ""), 2)
+}
+
+func TestImportStateLookupIntID(t *testing.T) {
+	t.Parallel()
+
+	lookups := map[string]ImportLookup{
+		"name=": func(value string) (int, error) {
+			if value == "test" {
+				return 5, nil
+			}
+
+			return 0, errLookup
+		},
+	}
+
+	tests := map[string]struct {
+		id       string
+		expected int64
+		error    bool
+	}{
+		"id": {
+			id:       "10",
+			expected: 10,
+		},
+		"lookup": {
+			id:       "name=test",
+			expected: 5,
+		},
+		"lookup error": {
+			id:    "name=other",
+			error: true,
+		},
+		"invalid": {
+			id:    "test",
+			error: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schema.Schema{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.Int64Attribute{Computed: true},
+						},
+					},
+					Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.Number}}, nil),
+				},
+			}
+
+			ImportStateLookupIntID(context.Background(), path.Root("id"), lookups, resource.ImportStateRequest{ID: test.id}, resp)
+
+			assert.Equal(t, test.error, resp.Diagnostics.HasError())
+
+			if !test.error {
+				var id types.Int64
+
+				resp.State.GetAttribute(context.Background(), path.Root("id"), &id)
+				assert.Equal(t, test.expected, id.ValueInt64())
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultTimeout is the operation timeout used when none is configured.
+const DefaultTimeout = 20 * time.Minute
+
+// AttributeGetter is implemented by both plan and state.
+type AttributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// TimeoutsAttribute returns the schema of the resource operation timeouts.
+func TimeoutsAttribute() schema.SingleNestedAttribute {
+	attributes := make(map[string]schema.Attribute)
+
+	for _, operation := range []string{Create, Read, Update, Delete} {
+		attributes[operation] = schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Timeout for %s operations, as a duration string such as `30s` or `2h45m`. Defaults to `20m`.", operation),
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`),
+					"must be a valid duration string",
+				),
+			},
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Operation timeouts.",
+		Optional:            true,
+		Attributes:          attributes,
+	}
+}
+
+// Timeout returns a context bound to the timeout configured for the given operation.
+func Timeout(ctx context.Context, source AttributeGetter, operation string, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	var value types.String
+
+	timeout := DefaultTimeout
+
+	diags.Append(source.GetAttribute(ctx, path.Root("timeouts").AtName(operation), &value)...)
+
+	if !value.IsNull() && !value.IsUnknown() {
+		parsed, err := time.ParseDuration(value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("timeouts").AtName(operation),
+				"Invalid Timeout",
+				fmt.Sprintf("Unable to parse %s timeout, got error: %s", operation, err),
+			)
+		} else {
+			timeout = parsed
+		}
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
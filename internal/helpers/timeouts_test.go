@@ -0,0 +1,92 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeout(t *testing.T) {
+	t.Parallel()
+
+	timeoutsType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		Create: tftypes.String,
+		Read:   tftypes.String,
+		Update: tftypes.String,
+		Delete: tftypes.String,
+	}}
+
+	tests := map[string]struct {
+		timeouts tftypes.Value
+		expected time.Duration
+		error    bool
+	}{
+		"default": {
+			timeouts: tftypes.NewValue(timeoutsType, nil),
+			expected: DefaultTimeout,
+		},
+		"unset operation": {
+			timeouts: tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+				Create: tftypes.NewValue(tftypes.String, nil),
+				Read:   tftypes.NewValue(tftypes.String, "1m"),
+				Update: tftypes.NewValue(tftypes.String, nil),
+				Delete: tftypes.NewValue(tftypes.String, nil),
+			}),
+			expected: DefaultTimeout,
+		},
+		"configured": {
+			timeouts: tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+				Create: tftypes.NewValue(tftypes.String, "90s"),
+				Read:   tftypes.NewValue(tftypes.String, nil),
+				Update: tftypes.NewValue(tftypes.String, nil),
+				Delete: tftypes.NewValue(tftypes.String, nil),
+			}),
+			expected: 90 * time.Second,
+		},
+		"invalid": {
+			timeouts: tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+				Create: tftypes.NewValue(tftypes.String, "soon"),
+				Read:   tftypes.NewValue(tftypes.String, nil),
+				Update: tftypes.NewValue(tftypes.String, nil),
+				Delete: tftypes.NewValue(tftypes.String, nil),
+			}),
+			expected: DefaultTimeout,
+			error:    true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := tfsdk.State{
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"timeouts": TimeoutsAttribute(),
+					},
+				},
+				Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"timeouts": timeoutsType}}, map[string]tftypes.Value{
+					"timeouts": test.timeouts,
+				}),
+			}
+
+			diags := diag.Diagnostics{}
+			start := time.Now()
+
+			ctx, cancel := Timeout(context.Background(), state, Create, &diags)
+			defer cancel()
+
+			deadline, ok := ctx.Deadline()
+			assert.True(t, ok)
+			assert.WithinDuration(t, start.Add(test.expected), deadline, time.Second)
+			assert.Equal(t, test.error, diags.HasError())
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package helpers
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+var (
+	ErrInvalidCACertificate  = errors.New("no valid PEM certificate found")
+	ErrIncompleteCertificate = errors.New("client certificate and client key must be set together")
+)
+
+// TLSOptions contains the TLS settings for the Radarr connection.
+type TLSOptions struct {
+	CACertificate      string
+	CACertificateFile  string
+	ClientCertificate  string
+	ClientKey          string
+	InsecureSkipVerify bool
+}
+
+// TLSConfig builds the TLS configuration for the Radarr connection.
+func (o TLSOptions) TLSConfig() (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: o.InsecureSkipVerify,
+	}
+
+	if o.CACertificate != "" || o.CACertificateFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if o.CACertificate != "" && !pool.AppendCertsFromPEM([]byte(o.CACertificate)) {
+			return nil, fmt.Errorf("unable to load CA certificate: %w", ErrInvalidCACertificate)
+		}
+
+		if o.CACertificateFile != "" {
+			ca, err := os.ReadFile(o.CACertificateFile)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read CA certificate file: %w", err)
+			}
+
+			if !pool.AppendCertsFromPEM(ca) {
+				return nil, fmt.Errorf("unable to load CA certificate file %s: %w", o.CACertificateFile, ErrInvalidCACertificate)
+			}
+		}
+
+		config.RootCAs = pool
+	}
+
+	if (o.ClientCertificate == "") != (o.ClientKey == "") {
+		return nil, ErrIncompleteCertificate
+	}
+
+	if o.ClientCertificate != "" {
+		cert, err := tls.X509KeyPair([]byte(o.ClientCertificate), []byte(o.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
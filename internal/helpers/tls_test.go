@@ -0,0 +1,151 @@
+package helpers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "radarr"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+func TestTLSOptions(t *testing.T) {
+	t.Parallel()
+
+	cert, key := testCertificate(t)
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	assert.NoError(t, os.WriteFile(caFile, []byte(cert), 0o600))
+
+	tests := map[string]struct {
+		options      TLSOptions
+		certificates int
+		rootCAs      bool
+		err          bool
+	}{
+		"empty": {
+			options: TLSOptions{},
+		},
+		"insecure": {
+			options: TLSOptions{InsecureSkipVerify: true},
+		},
+		"ca": {
+			options: TLSOptions{CACertificate: cert},
+			rootCAs: true,
+		},
+		"ca file": {
+			options: TLSOptions{CACertificateFile: caFile},
+			rootCAs: true,
+		},
+		"ca and ca file": {
+			options: TLSOptions{CACertificate: cert, CACertificateFile: caFile},
+			rootCAs: true,
+		},
+		"invalid ca": {
+			options: TLSOptions{CACertificate: "invalid"},
+			err:     true,
+		},
+		"missing ca file": {
+			options: TLSOptions{CACertificateFile: filepath.Join(t.TempDir(), "missing.pem")},
+			err:     true,
+		},
+		"client certificate": {
+			options:      TLSOptions{ClientCertificate: cert, ClientKey: key},
+			certificates: 1,
+		},
+		"client certificate without key": {
+			options: TLSOptions{ClientCertificate: cert},
+			err:     true,
+		},
+		"invalid client certificate": {
+			options: TLSOptions{ClientCertificate: cert, ClientKey: "invalid"},
+			err:     true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config, err := test.options.TLSConfig()
+			if test.err {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.options.InsecureSkipVerify, config.InsecureSkipVerify)
+			assert.Equal(t, test.rootCAs, config.RootCAs != nil)
+			assert.Len(t, config.Certificates, test.certificates)
+		})
+	}
+}
+
+func TestTLSOptionsServer(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ca := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	for name, options := range map[string]TLSOptions{
+		"custom ca": {CACertificate: ca},
+		"insecure":  {InsecureSkipVerify: true},
+	} {
+		config, err := options.TLSConfig()
+		assert.NoError(t, err, name)
+
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: config}}
+		resp, err := client.Get(server.URL)
+		assert.NoError(t, err, name)
+
+		if err == nil {
+			resp.Body.Close()
+			assert.Equal(t, http.StatusOK, resp.StatusCode, name)
+		}
+	}
+
+	config, err := TLSOptions{}.TLSConfig()
+	assert.NoError(t, err)
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: config}}
+	_, err = client.Get(server.URL)
+	assert.Error(t, err)
+}
@@ -0,0 +1,48 @@
+package helpers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// TimeoutTransport is a http.RoundTripper bounding each request, including its retries, to a timeout.
+type TimeoutTransport struct {
+	Transport http.RoundTripper
+	Timeout   time.Duration
+}
+
+// RoundTrip executes a single HTTP transaction within the timeout.
+func (t *TimeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Timeout <= 0 {
+		return t.Transport.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.Timeout)
+
+	resp, err := t.Transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	// the context must stay alive until the body has been read.
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+// cancelBody releases the request context once the response body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+
+	return err
+}
@@ -0,0 +1,40 @@
+package helpers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeoutTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(200 * time.Millisecond)
+		}
+
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &TimeoutTransport{
+		Transport: http.DefaultTransport,
+		Timeout:   100 * time.Millisecond,
+	}}
+
+	resp, err := client.Get(server.URL + "/fast")
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", string(body))
+
+	_, err = client.Get(server.URL + "/slow")
+	assert.Error(t, err)
+}
@@ -0,0 +1,65 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrUnsupportedVersion = errors.New("unsupported Radarr version")
+	ErrInvalidVersion     = errors.New("invalid version")
+)
+
+// CompareVersions compares two dotted versions (e.g. `5.3.6.8612`), returning -1, 0 or 1.
+// Missing components are considered as 0.
+func CompareVersions(a, b string) (int, error) {
+	first, err := parseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+
+	second, err := parseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range max(len(first), len(second)) {
+		var x, y int
+
+		if i < len(first) {
+			x = first[i]
+		}
+
+		if i < len(second) {
+			y = second[i]
+		}
+
+		if x != y {
+			if x < y {
+				return -1, nil
+			}
+
+			return 1, nil
+		}
+	}
+
+	return 0, nil
+}
+
+func parseVersion(version string) ([]int, error) {
+	parts := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	numbers := make([]int, len(parts))
+
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidVersion, version)
+		}
+
+		numbers[i] = number
+	}
+
+	return numbers, nil
+}
@@ -0,0 +1,66 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareVersions(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		a        string
+		b        string
+		expected int
+		err      bool
+	}{
+		"equal": {
+			a:        "5.3.6.8612",
+			b:        "5.3.6.8612",
+			expected: 0,
+		},
+		"missing components": {
+			a:        "5.0",
+			b:        "5.0.0.0",
+			expected: 0,
+		},
+		"older": {
+			a:        "4.7.5.7809",
+			b:        "5.0",
+			expected: -1,
+		},
+		"newer": {
+			a:        "5.10.1",
+			b:        "5.9.9",
+			expected: 1,
+		},
+		"prefix": {
+			a:        "v5.1",
+			b:        "5.0",
+			expected: 1,
+		},
+		"invalid": {
+			a:   "5.x",
+			b:   "5.0",
+			err: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := CompareVersions(test.a, test.b)
+			if test.err {
+				assert.ErrorIs(t, err, ErrInvalidVersion)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
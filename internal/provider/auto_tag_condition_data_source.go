@@ -32,7 +32,7 @@ func NewAutoTagConditionDataSource() datasource.DataSource {
 // AutoTagConditionDataSource defines the auto tag condition implementation.
 type AutoTagConditionDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // AutoTagCondition describes the auto tag condition data model.
@@ -27,7 +27,7 @@ func NewAutoTagConditionGenresDataSource() datasource.DataSource {
 // AutoTagConditionGenresDataSource defines the auto_tag_condition_genre implementation.
 type AutoTagConditionGenresDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *AutoTagConditionGenresDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -27,7 +27,7 @@ func NewAutoTagConditionMonitoredDataSource() datasource.DataSource {
 // AutoTagConditionMonitoredDataSource defines the auto_tag_condition_genre implementation.
 type AutoTagConditionMonitoredDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *AutoTagConditionMonitoredDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
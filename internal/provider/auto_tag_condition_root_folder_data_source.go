@@ -27,7 +27,7 @@ func NewAutoTagConditionRootFolderDataSource() datasource.DataSource {
 // AutoTagConditionRootFolderDataSource defines the auto_tag_condition_root folder implementation.
 type AutoTagConditionRootFolderDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *AutoTagConditionRootFolderDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -27,7 +27,7 @@ func NewAutoTagConditionYearDataSource() datasource.DataSource {
 // AutoTagConditionYearDataSource defines the auto_tag_condition_series type implementation.
 type AutoTagConditionYearDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *AutoTagConditionYearDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -24,7 +24,7 @@ func NewAutoTagDataSource() datasource.DataSource {
 // AutoTagDataSource defines the auto_tag implementation.
 type AutoTagDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *AutoTagDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -102,6 +102,8 @@ func (d *AutoTagDataSource) Configure(ctx context.Context, req datasource.Config
 }
 
 func (d *AutoTagDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	var data *AutoTag
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -110,7 +112,7 @@ func (d *AutoTagDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 	// Get autoTag current value
-	response, _, err := d.client.AutoTaggingAPI.ListAutoTagging(d.auth).Execute()
+	response, _, err := d.client.AutoTaggingAPI.ListAutoTagging(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, autoTagDataSourceName, err))
 
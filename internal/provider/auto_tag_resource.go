@@ -33,7 +33,7 @@ func NewAutoTagResource() resource.Resource {
 // AutoTagResource defines the tag implementation.
 type AutoTagResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // AutoTag describes the tag data model.
@@ -45,6 +45,12 @@ type AutoTag struct {
 	RemoveTagsAutomatically types.Bool   `tfsdk:"remove_tags_automatically"`
 }
 
+// AutoTagResourceModel describes the tag resource data model.
+type AutoTagResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	AutoTag
+}
+
 func (t AutoTag) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -64,6 +70,7 @@ func (r *AutoTagResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Tags -->\nAuto Tag resource.\nFor more information refer to [Tags](https://wiki.servarr.com/radarr/settings#tags) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"remove_tags_automatically": schema.BoolAttribute{
 				MarkdownDescription: "Remove tags automatically flag.",
 				Optional:            true,
@@ -148,8 +155,11 @@ func (r *AutoTagResource) Configure(ctx context.Context, req resource.ConfigureR
 }
 
 func (r *AutoTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var autoTag *AutoTag
+	var autoTag *AutoTagResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &autoTag)...)
 
@@ -160,9 +170,9 @@ func (r *AutoTagResource) Create(ctx context.Context, req resource.CreateRequest
 	// Create new auto tag
 	request := autoTag.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.AutoTaggingAPI.CreateAutoTagging(r.auth).AutoTaggingResource(*request).Execute()
+	response, _, err := r.client.AutoTaggingAPI.CreateAutoTagging(ctx).AutoTaggingResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, autoTagResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, autoTagResourceName, err)
 
 		return
 	}
@@ -174,8 +184,11 @@ func (r *AutoTagResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *AutoTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var autoTag *AutoTag
+	var autoTag *AutoTagResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &autoTag)...)
 
@@ -184,8 +197,12 @@ func (r *AutoTagResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Get auto tag current value
-	response, _, err := r.client.AutoTaggingAPI.GetAutoTaggingById(r.auth, int32(autoTag.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.AutoTaggingAPI.GetAutoTaggingById(ctx, int32(autoTag.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, autoTagResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, autoTagResourceName, err))
 
 		return
@@ -198,8 +215,11 @@ func (r *AutoTagResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *AutoTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var autoTag *AutoTag
+	var autoTag *AutoTagResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &autoTag)...)
 
@@ -210,9 +230,9 @@ func (r *AutoTagResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Update auto tag
 	request := autoTag.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.AutoTaggingAPI.UpdateAutoTagging(r.auth, fmt.Sprint(request.GetId())).AutoTaggingResource(*request).Execute()
+	response, _, err := r.client.AutoTaggingAPI.UpdateAutoTagging(ctx, fmt.Sprint(request.GetId())).AutoTaggingResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, autoTagResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, autoTagResourceName, err)
 
 		return
 	}
@@ -224,6 +244,9 @@ func (r *AutoTagResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *AutoTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -233,7 +256,7 @@ func (r *AutoTagResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	// Delete auto tag current value
-	_, err := r.client.AutoTaggingAPI.DeleteAutoTagging(r.auth, int32(ID)).Execute()
+	_, err := r.client.AutoTaggingAPI.DeleteAutoTagging(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, autoTagResourceName, err))
 
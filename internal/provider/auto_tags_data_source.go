@@ -24,7 +24,7 @@ func NewAutoTagsDataSource() datasource.DataSource {
 // AutoTagsDataSource defines the download clients implementation.
 type AutoTagsDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // AutoTags describes the download clients data model.
@@ -120,8 +120,10 @@ func (d *AutoTagsDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *AutoTagsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get download clients current value
-	response, _, err := d.client.AutoTaggingAPI.ListAutoTagging(d.auth).Execute()
+	response, _, err := d.client.AutoTaggingAPI.ListAutoTagging(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, autoTagsDataSourceName, err))
 
@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const collectionDataSourceName = "collection"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CollectionDataSource{}
+
+func NewCollectionDataSource() datasource.DataSource {
+	return &CollectionDataSource{}
+}
+
+// CollectionDataSource defines the collection implementation.
+type CollectionDataSource struct {
+	client *radarr.APIClient
+	auth   radarrAuth
+}
+
+// CollectionDetails extends Collection with its member movies.
+type CollectionDetails struct {
+	Movies types.List `tfsdk:"movies"`
+	Collection
+	MissingMovies types.Int64 `tfsdk:"missing_movies"`
+}
+
+// CollectionMovie is part of CollectionDetails.
+type CollectionMovie struct {
+	Title      types.String `tfsdk:"title"`
+	IMDBID     types.String `tfsdk:"imdb_id"`
+	Status     types.String `tfsdk:"status"`
+	Folder     types.String `tfsdk:"folder"`
+	TMDBID     types.Int64  `tfsdk:"tmdb_id"`
+	Year       types.Int64  `tfsdk:"year"`
+	IsExisting types.Bool   `tfsdk:"is_existing"`
+	IsExcluded types.Bool   `tfsdk:"is_excluded"`
+}
+
+func (c CollectionDetails) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"movies":               types.ListType{}.WithElementType(CollectionMovie{}.getType()),
+			"tags":                 types.SetType{}.WithElementType(types.Int64Type),
+			"title":                types.StringType,
+			"root_folder_path":     types.StringType,
+			"minimum_availability": types.StringType,
+			"id":                   types.Int64Type,
+			"tmdb_id":              types.Int64Type,
+			"quality_profile_id":   types.Int64Type,
+			"missing_movies":       types.Int64Type,
+			"monitored":            types.BoolType,
+			"search_on_add":        types.BoolType,
+		})
+}
+
+func (m CollectionMovie) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"title":       types.StringType,
+			"imdb_id":     types.StringType,
+			"status":      types.StringType,
+			"folder":      types.StringType,
+			"tmdb_id":     types.Int64Type,
+			"year":        types.Int64Type,
+			"is_existing": types.BoolType,
+			"is_excluded": types.BoolType,
+		})
+}
+
+func (d *CollectionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + collectionDataSourceName
+}
+
+func (d *CollectionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Movies -->\nSingle [Collection](../resources/collection).",
+		Attributes:          d.getCollectionSchema(true).Attributes,
+	}
+}
+
+// getCollectionSchema returns the collection attributes, the TMDB ID is the search key of the single collection data source.
+func (d CollectionDataSource) getCollectionSchema(search bool) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tmdb_id": schema.Int64Attribute{
+				MarkdownDescription: "TMDB collection ID.",
+				Required:            search,
+				Computed:            !search,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Collection ID.",
+				Computed:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Collection title.",
+				Computed:            true,
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag.",
+				Computed:            true,
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality profile ID of the added movies.",
+				Computed:            true,
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Root folder path of the added movies.",
+				Computed:            true,
+			},
+			"minimum_availability": schema.StringAttribute{
+				MarkdownDescription: "Minimum availability of the added movies.",
+				Computed:            true,
+			},
+			"search_on_add": schema.BoolAttribute{
+				MarkdownDescription: "Search for the movies when they are added.",
+				Computed:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"missing_movies": schema.Int64Attribute{
+				MarkdownDescription: "Number of movies not in the library.",
+				Computed:            true,
+			},
+			"movies": schema.ListNestedAttribute{
+				MarkdownDescription: "Member movies.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tmdb_id": schema.Int64Attribute{
+							MarkdownDescription: "TMDB ID.",
+							Computed:            true,
+						},
+						"year": schema.Int64Attribute{
+							MarkdownDescription: "Year.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Movie title.",
+							Computed:            true,
+						},
+						"imdb_id": schema.StringAttribute{
+							MarkdownDescription: "IMDB ID.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Movie status.",
+							Computed:            true,
+						},
+						"folder": schema.StringAttribute{
+							MarkdownDescription: "Suggested folder name.",
+							Computed:            true,
+						},
+						"is_existing": schema.BoolAttribute{
+							MarkdownDescription: "Whether the movie is in the library.",
+							Computed:            true,
+						},
+						"is_excluded": schema.BoolAttribute{
+							MarkdownDescription: "Whether the movie is in the import list exclusions.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CollectionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *CollectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
+	var data *CollectionDetails
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get collections current value
+	response, _, err := d.client.CollectionAPI.ListCollection(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, collectionDataSourceName, err))
+
+		return
+	}
+
+	data.find(ctx, data.TMDBID.ValueInt64(), response, &resp.Diagnostics)
+	tflog.Trace(ctx, "read "+collectionDataSourceName)
+	// Map response body to resource schema attribute
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (c *CollectionDetails) find(ctx context.Context, tmdbID int64, collections []radarr.CollectionResource, diags *diag.Diagnostics) {
+	for _, collection := range collections {
+		if int64(collection.GetTmdbId()) == tmdbID {
+			c.write(ctx, &collection, diags)
+
+			return
+		}
+	}
+
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(collectionDataSourceName, "TMDB ID", strconv.Itoa(int(tmdbID))))
+}
+
+func (c *CollectionDetails) write(ctx context.Context, collection *radarr.CollectionResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	c.Collection.write(ctx, collection, diags)
+	c.MissingMovies = types.Int64Value(int64(collection.GetMissingMovies()))
+
+	movies := make([]CollectionMovie, len(collection.Movies))
+	for i, m := range collection.Movies {
+		movies[i].write(&m)
+	}
+
+	c.Movies, tempDiag = types.ListValueFrom(ctx, CollectionMovie{}.getType(), movies)
+	diags.Append(tempDiag...)
+}
+
+func (m *CollectionMovie) write(movie *radarr.CollectionMovieResource) {
+	m.TMDBID = types.Int64Value(int64(movie.GetTmdbId()))
+	m.Year = types.Int64Value(int64(movie.GetYear()))
+	m.Title = types.StringValue(movie.GetTitle())
+	m.IMDBID = types.StringValue(movie.GetImdbId())
+	m.Status = types.StringValue(string(movie.GetStatus()))
+	m.Folder = types.StringValue(movie.GetFolder())
+	m.IsExisting = types.BoolValue(movie.GetIsExisting())
+	m.IsExcluded = types.BoolValue(movie.GetIsExcluded())
+}
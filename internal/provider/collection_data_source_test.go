@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccCollectionDataSourceConfig("999") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found testing
+			{
+				Config:      testAccCollectionDataSourceConfig("999"),
+				ExpectError: regexp.MustCompile("Unable to find collection"),
+			},
+			// Read testing
+			{
+				Config: testAccMovieResourceConfig("Toy Story", "Toy_Story_1995", 862) + testAccCollectionDataSourceReadConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.radarr_collection.test", "title", "Toy Story Collection"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.radarr_collection.test", "movies.*", map[string]string{"tmdb_id": "862", "is_existing": "true"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCollectionDataSourceConfig(id string) string {
+	return fmt.Sprintf(`
+	data "radarr_collection" "test" {
+		tmdb_id = %s
+	}
+	`, id)
+}
+
+const testAccCollectionDataSourceReadConfig = `
+data "radarr_collection" "test" {
+	tmdb_id = 10194
+	depends_on = [radarr_movie.test]
+}
+`
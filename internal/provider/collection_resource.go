@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const collectionResourceName = "collection"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &CollectionResource{}
+	_ resource.ResourceWithImportState = &CollectionResource{}
+)
+
+func NewCollectionResource() resource.Resource {
+	return &CollectionResource{}
+}
+
+// CollectionResource defines the collection implementation.
+type CollectionResource struct {
+	client *radarr.APIClient
+	auth   radarrAuth
+}
+
+// Collection describes the collection data model.
+type Collection struct {
+	Tags                types.Set    `tfsdk:"tags"`
+	Title               types.String `tfsdk:"title"`
+	RootFolderPath      types.String `tfsdk:"root_folder_path"`
+	MinimumAvailability types.String `tfsdk:"minimum_availability"`
+	ID                  types.Int64  `tfsdk:"id"`
+	TMDBID              types.Int64  `tfsdk:"tmdb_id"`
+	QualityProfileID    types.Int64  `tfsdk:"quality_profile_id"`
+	Monitored           types.Bool   `tfsdk:"monitored"`
+	SearchOnAdd         types.Bool   `tfsdk:"search_on_add"`
+}
+
+// CollectionResourceModel describes the collection resource data model.
+type CollectionResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	Collection
+}
+
+func (c Collection) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"tags":                 types.SetType{}.WithElementType(types.Int64Type),
+			"title":                types.StringType,
+			"root_folder_path":     types.StringType,
+			"minimum_availability": types.StringType,
+			"id":                   types.Int64Type,
+			"tmdb_id":              types.Int64Type,
+			"quality_profile_id":   types.Int64Type,
+			"monitored":            types.BoolType,
+			"search_on_add":        types.BoolType,
+		})
+}
+
+func (r *CollectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + collectionResourceName
+}
+
+func (r *CollectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Movies -->\nCollection resource.\nRadarr creates a collection when one of its movies is added, this resource manages the settings of an existing collection. Destroying it only removes it from the state.\nFor more information refer to [Collections](https://wiki.servarr.com/radarr/library#collections) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Collection ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"tmdb_id": schema.Int64Attribute{
+				MarkdownDescription: "TMDB collection ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Collection title.",
+				Computed:            true,
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag. Missing movies of a monitored collection are added to Radarr.",
+				Required:            true,
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality profile ID of the added movies.",
+				Required:            true,
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Root folder path of the added movies.",
+				Required:            true,
+			},
+			"minimum_availability": schema.StringAttribute{
+				MarkdownDescription: "Minimum availability of the added movies.\nAllowed values: 'tba', 'announced', 'inCinemas', 'released', 'deleted'.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(radarr.MOVIESTATUSTYPE_RELEASED)),
+				Validators: []validator.String{
+					stringvalidator.OneOf("tba", "announced", "inCinemas", "released", "deleted"),
+				},
+			},
+			"search_on_add": schema.BoolAttribute{
+				MarkdownDescription: "Search for the movies when they are added.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CollectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
+	// Retrieve values from plan
+	var collection *CollectionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &collection)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Find the existing collection
+	collections, _, err := r.client.CollectionAPI.ListCollection(ctx).TmdbId(int32(collection.TMDBID.ValueInt64())).Execute()
+	if err != nil {
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, collectionResourceName, err)
+
+		return
+	}
+
+	index := slices.IndexFunc(collections, func(c radarr.CollectionResource) bool {
+		return int64(c.GetTmdbId()) == collection.TMDBID.ValueInt64()
+	})
+	if index < 0 {
+		resp.Diagnostics.AddError(helpers.ResourceError, helpers.ParseNotFoundError(collectionResourceName, "TMDB ID", strconv.Itoa(int(collection.TMDBID.ValueInt64()))))
+
+		return
+	}
+
+	// Update Collection
+	collection.ID = types.Int64Value(int64(collections[index].GetId()))
+	request := collection.read(ctx, &resp.Diagnostics)
+
+	// keep the current tags if not managed.
+	if collection.Tags.IsUnknown() {
+		request.Tags = collections[index].Tags
+	}
+
+	response, _, err := r.client.CollectionAPI.UpdateCollection(ctx, strconv.Itoa(int(request.GetId()))).CollectionResource(*request).Execute()
+	if err != nil {
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, collectionResourceName, err)
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+collectionResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	collection.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &collection)...)
+}
+
+func (r *CollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
+	// Get current state
+	var collection *CollectionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &collection)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get collection current value
+	response, httpResp, err := r.client.CollectionAPI.GetCollectionById(ctx, int32(collection.ID.ValueInt64())).Execute()
+	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, collectionResourceName, httpResp, err, resp) {
+			return
+		}
+
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, collectionResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+collectionResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	collection.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &collection)...)
+}
+
+func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
+	// Get plan values
+	var collection *CollectionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &collection)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update Collection
+	request := collection.read(ctx, &resp.Diagnostics)
+
+	response, _, err := r.client.CollectionAPI.UpdateCollection(ctx, strconv.Itoa(int(request.GetId()))).CollectionResource(*request).Execute()
+	if err != nil {
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, collectionResourceName, err)
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+collectionResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	collection.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &collection)...)
+}
+
+func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Collection cannot be really deleted just removing configuration
+	tflog.Trace(ctx, "decoupled "+collectionResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *CollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), collectionImportLookups(r.auth.apiContext(ctx), r.client), req, resp)
+	tflog.Trace(ctx, "imported "+collectionResourceName+": "+req.ID)
+}
+
+// collectionImportLookups resolves the collection import identifiers.
+func collectionImportLookups(ctx context.Context, client *radarr.APIClient) map[string]helpers.ImportLookup {
+	return map[string]helpers.ImportLookup{
+		"tmdb:": func(value string) (int, error) {
+			collections, _, err := client.CollectionAPI.ListCollection(ctx).Execute()
+			if err != nil {
+				return 0, err
+			}
+
+			return helpers.FindImportID(collections, value, func(c *radarr.CollectionResource) string { return strconv.Itoa(int(c.GetTmdbId())) })
+		},
+		"title=": func(title string) (int, error) {
+			collections, _, err := client.CollectionAPI.ListCollection(ctx).Execute()
+			if err != nil {
+				return 0, err
+			}
+
+			return helpers.FindImportID(collections, title, (*radarr.CollectionResource).GetTitle)
+		},
+	}
+}
+
+func (c *Collection) write(ctx context.Context, collection *radarr.CollectionResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	c.ID = types.Int64Value(int64(collection.GetId()))
+	c.TMDBID = types.Int64Value(int64(collection.GetTmdbId()))
+	c.QualityProfileID = types.Int64Value(int64(collection.GetQualityProfileId()))
+	c.Title = types.StringValue(collection.GetTitle())
+	c.RootFolderPath = types.StringValue(collection.GetRootFolderPath())
+	c.MinimumAvailability = types.StringValue(string(collection.GetMinimumAvailability()))
+	c.Monitored = types.BoolValue(collection.GetMonitored())
+	c.SearchOnAdd = types.BoolValue(collection.GetSearchOnAdd())
+	c.Tags, tempDiag = types.SetValueFrom(ctx, types.Int64Type, collection.GetTags())
+	diags.Append(tempDiag...)
+}
+
+func (c *Collection) read(ctx context.Context, diags *diag.Diagnostics) *radarr.CollectionResource {
+	collection := radarr.NewCollectionResource()
+	collection.SetId(int32(c.ID.ValueInt64()))
+	collection.SetTmdbId(int32(c.TMDBID.ValueInt64()))
+	collection.SetQualityProfileId(int32(c.QualityProfileID.ValueInt64()))
+	collection.SetRootFolderPath(c.RootFolderPath.ValueString())
+	collection.SetMinimumAvailability(radarr.MovieStatusType(c.MinimumAvailability.ValueString()))
+	collection.SetMonitored(c.Monitored.ValueBool())
+	collection.SetSearchOnAdd(c.SearchOnAdd.ValueBool())
+
+	if !c.Tags.IsUnknown() {
+		diags.Append(c.Tags.ElementsAs(ctx, &collection.Tags, true)...)
+	}
+
+	return collection
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccCollectionResourceConfig("released") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found testing
+			{
+				Config:      testAccCollectionResourceNotFoundConfig,
+				ExpectError: regexp.MustCompile("Unable to find collection"),
+			},
+			// Create and Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccCollectionResourceConfig("released"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("radarr_collection.test", "id"),
+					resource.TestCheckResourceAttr("radarr_collection.test", "title", "Back to the Future Collection"),
+					resource.TestCheckResourceAttr("radarr_collection.test", "monitored", "false"),
+					resource.TestCheckResourceAttr("radarr_collection.test", "minimum_availability", "released"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccCollectionResourceConfig("released") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccCollectionResourceConfig("announced"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_collection.test", "minimum_availability", "announced"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "radarr_collection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState by tmdb testing
+			{
+				ResourceName:      "radarr_collection.test",
+				ImportStateId:     "tmdb:264",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCollectionResourceConfig keeps the collection unmonitored, otherwise Radarr adds its other movies to the shared instance.
+func testAccCollectionResourceConfig(availability string) string {
+	return testAccMovieResourceConfig("Back to the Future", "Back_to_the_Future_1985", 105) + fmt.Sprintf(`
+		resource "radarr_collection" "test" {
+			tmdb_id = 264
+			monitored = false
+			quality_profile_id = 1
+			root_folder_path = "/config"
+			minimum_availability = "%s"
+
+			depends_on = [radarr_movie.test]
+		}
+	`, availability)
+}
+
+const testAccCollectionResourceNotFoundConfig = `
+resource "radarr_collection" "test" {
+	tmdb_id = 1
+	monitored = false
+	quality_profile_id = 1
+	root_folder_path = "/config"
+}
+`
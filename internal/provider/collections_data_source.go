@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const collectionsDataSourceName = "collections"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CollectionsDataSource{}
+
+func NewCollectionsDataSource() datasource.DataSource {
+	return &CollectionsDataSource{}
+}
+
+// CollectionsDataSource defines the collections implementation.
+type CollectionsDataSource struct {
+	client *radarr.APIClient
+	auth   radarrAuth
+}
+
+// Collections describes the collections data model.
+type Collections struct {
+	Collections types.Set    `tfsdk:"collections"`
+	ID          types.String `tfsdk:"id"`
+}
+
+func (d *CollectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + collectionsDataSourceName
+}
+
+func (d *CollectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Movies -->\nList all available [Collections](../resources/collection).",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"collections": schema.SetNestedAttribute{
+				MarkdownDescription: "Collection list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: CollectionDataSource{}.getCollectionSchema(false).Attributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *CollectionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *CollectionsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
+	// Get collections current value
+	response, _, err := d.client.CollectionAPI.ListCollection(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, collectionsDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+collectionsDataSourceName)
+	// Map response body to resource schema attribute
+	collections := make([]CollectionDetails, len(response))
+	for i, c := range response {
+		collections[i].write(ctx, &c, &resp.Diagnostics)
+	}
+
+	collectionList, diags := types.SetValueFrom(ctx, CollectionDetails{}.getType(), collections)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, Collections{Collections: collectionList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccMovieResourceConfig("Error", "error", 0) + testAccCollectionsDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccMovieResourceConfig("Shrek", "Shrek_2001", 808) + testAccCollectionsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.radarr_collections.test", "collections.*", map[string]string{"tmdb_id": "2150", "title": "Shrek Collection"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccCollectionsDataSourceConfig = `
+data "radarr_collections" "test" {
+	depends_on = [radarr_movie.test]
+}
+`
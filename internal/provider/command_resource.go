@@ -0,0 +1,406 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	commandResourceName = "command"
+	commandPollInterval = 2 * time.Second
+)
+
+var errCommandRejected = errors.New("command rejected")
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CommandResource{}
+
+func NewCommandResource() resource.Resource {
+	return &CommandResource{}
+}
+
+// CommandResource defines the command implementation.
+type CommandResource struct {
+	client *radarr.APIClient
+	auth   radarrAuth
+}
+
+// Command describes the command data model.
+type Command struct {
+	Triggers types.Map    `tfsdk:"triggers"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+	Name     types.String `tfsdk:"name"`
+	Body     types.String `tfsdk:"body"`
+	Status   types.String `tfsdk:"status"`
+	Result   types.String `tfsdk:"result"`
+	Message  types.String `tfsdk:"message"`
+	Duration types.String `tfsdk:"duration"`
+	Started  types.String `tfsdk:"started"`
+	Ended    types.String `tfsdk:"ended"`
+	ID       types.Int64  `tfsdk:"id"`
+}
+
+func (r *CommandResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + commandResourceName
+}
+
+func (r *CommandResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nCommand resource.\nRuns a Radarr command, such as `RssSync`, `RefreshMovie` or `RenameMovie`, and waits for it to complete. The apply fails if the command fails.\nThe command runs again when any attribute changes, use `triggers` to re-run it on changes of other resources.\nDestroying the resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Command ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Command name, such as `RefreshMovie`, `MoviesSearch`, `RenameMovie`, `RssSync`, `Backup` or `ApplicationCheckUpdate`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "JSON object with the command parameters, such as `jsonencode({ movieIds = [1] })`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values, any change runs the command again.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Final command status.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"result": schema.StringAttribute{
+				MarkdownDescription: "Command result.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Last command message.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"duration": schema.StringAttribute{
+				MarkdownDescription: "Command duration.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"started": schema.StringAttribute{
+				MarkdownDescription: "Start time in RFC3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ended": schema.StringAttribute{
+				MarkdownDescription: "End time in RFC3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CommandResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *CommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
+	var command *Command
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := make(map[string]interface{})
+
+	if !command.Body.IsNull() {
+		if err := json.Unmarshal([]byte(command.Body.ValueString()), &payload); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("body"), helpers.ValidationError, "body must be a JSON object, got error: "+err.Error())
+
+			return
+		}
+	}
+
+	payload["name"] = command.Name.ValueString()
+
+	// Send the command
+	response, err := sendCommand(ctx, r.client, r.auth, payload)
+	if err != nil {
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, commandResourceName, err)
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+commandResourceName+": "+strconv.Itoa(int(response.GetId())))
+
+	// Wait for the command to complete
+	response, err = waitCommand(ctx, r.client, r.auth, response)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, commandResourceName, err))
+
+		return
+	}
+
+	// Generate resource state struct, a failed command is saved to be run again
+	command.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+
+	if !commandSucceeded(response) {
+		resp.Diagnostics.AddError(helpers.ResourceError, fmt.Sprintf("Command %s %s: %s", command.Name.ValueString(), command.Status.ValueString(), command.Message.ValueString()))
+	}
+}
+
+func (r *CommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
+	var command *Command
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get command current value, Radarr purges finished commands so the last known outcome is kept
+	response, httpResp, err := r.client.CommandAPI.GetCommandById(ctx, int32(command.ID.ValueInt64())).Execute()
+	if helpers.IsNotFound(httpResp, err) {
+		tflog.Trace(ctx, "purged "+commandResourceName+": "+strconv.Itoa(int(command.ID.ValueInt64())))
+
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, commandResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+commandResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	command.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+}
+
+func (r *CommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Any attribute but timeouts requires replace, so there is nothing to send
+	var command *Command
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+commandResourceName+": "+strconv.Itoa(int(command.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+}
+
+func (r *CommandResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Commands cannot be undone just removing configuration
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "decoupled "+commandResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+// sendCommand posts a command, the parameters are top level fields that the client command model cannot hold.
+func sendCommand(ctx context.Context, client *radarr.APIClient, auth radarrAuth, payload map[string]interface{}) (*radarr.CommandResource, error) {
+	config := client.GetConfig()
+
+	serverURL, err := config.ServerURLWithContext(ctx, "CommandAPIService.CreateCommand")
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, serverURL+"/api/v3/command", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range config.DefaultHeader {
+		request.Header.Set(key, value)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("User-Agent", config.UserAgent)
+	request.Header.Set("X-Api-Key", auth.apiKey)
+
+	response, err := config.HTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err = io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		return nil, &commandError{status: response.Status, body: body}
+	}
+
+	command := radarr.NewCommandResource()
+	if err := json.Unmarshal(body, command); err != nil {
+		return nil, err
+	}
+
+	return command, nil
+}
+
+// commandError is a command rejected by Radarr, the body holds the validation failures.
+type commandError struct {
+	status string
+	body   []byte
+}
+
+func (e *commandError) Error() string {
+	return fmt.Sprintf("%s: %s\nDetails:\n%s", errCommandRejected, e.status, string(e.body))
+}
+
+func (e *commandError) Unwrap() error {
+	return errCommandRejected
+}
+
+// Body returns the response body.
+func (e *commandError) Body() []byte {
+	return e.body
+}
+
+// waitCommand polls the command until it reaches a final status and drops the cached reads.
+func waitCommand(ctx context.Context, client *radarr.APIClient, auth radarrAuth, command *radarr.CommandResource) (*radarr.CommandResource, error) {
+	// the command changes Radarr after the request that created it, so the cached reads get stale.
+	defer auth.invalidateCache()
+
+	for !commandFinished(command) {
+		tflog.Debug(ctx, "waiting for "+commandResourceName, map[string]interface{}{
+			"id":     command.GetId(),
+			"status": command.GetStatus(),
+		})
+
+		timer := time.NewTimer(commandPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return nil, fmt.Errorf("command %d still %s: %w", command.GetId(), command.GetStatus(), ctx.Err())
+		case <-timer.C:
+		}
+
+		response, _, err := client.CommandAPI.GetCommandById(ctx, command.GetId()).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		command = response
+	}
+
+	return command, nil
+}
+
+// commandFinished reports whether the command reached a final status.
+func commandFinished(command *radarr.CommandResource) bool {
+	switch command.GetStatus() {
+	case radarr.COMMANDSTATUS_QUEUED, radarr.COMMANDSTATUS_STARTED:
+		return false
+	default:
+		return true
+	}
+}
+
+// commandSucceeded reports whether the finished command completed without errors.
+func commandSucceeded(command *radarr.CommandResource) bool {
+	return command.GetStatus() == radarr.COMMANDSTATUS_COMPLETED && command.GetResult() != radarr.COMMANDRESULT_UNSUCCESSFUL
+}
+
+func (c *Command) write(command *radarr.CommandResource) {
+	c.ID = types.Int64Value(int64(command.GetId()))
+	c.Status = types.StringValue(string(command.GetStatus()))
+	c.Result = types.StringValue(string(command.GetResult()))
+	c.Message = types.StringValue(command.GetMessage())
+	c.Duration = types.StringValue(command.GetDuration())
+	c.Started = commandTime(command.Started)
+	c.Ended = commandTime(command.Ended)
+
+	if command.GetException() != "" && c.Message.ValueString() == "" {
+		c.Message = types.StringValue(command.GetException())
+	}
+}
+
+// commandTime formats an optional command timestamp.
+func commandTime(value radarr.NullableTime) types.String {
+	if !value.IsSet() || value.Get() == nil {
+		return types.StringValue("")
+	}
+
+	return types.StringValue(value.Get().Format(time.RFC3339))
+}
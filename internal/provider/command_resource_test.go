@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCommandResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccCommandResourceConfig("RssSync", "1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Unknown command
+			{
+				Config:      testAccCommandResourceConfig("NotACommand", "1"),
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccCommandResourceConfig("RssSync", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_command.test", "status", "completed"),
+					resource.TestCheckResourceAttrSet("radarr_command.test", "id"),
+					resource.TestCheckResourceAttrSet("radarr_command.test", "ended"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccCommandResourceConfig("RssSync", "1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Trigger and Read testing
+			{
+				Config: testAccCommandResourceConfig("Backup", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_command.test", "status", "completed"),
+					resource.TestCheckResourceAttr("radarr_command.test", "triggers.version", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCommandResourceConfig(name, version string) string {
+	return fmt.Sprintf(`
+	resource "radarr_command" "test" {
+		name = "%s"
+		body = jsonencode({ type = "manual" })
+
+		triggers = {
+			version = "%s"
+		}
+	}`, name, version)
+}
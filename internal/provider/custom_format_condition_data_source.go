@@ -31,7 +31,7 @@ func NewCustomFormatConditionDataSource() datasource.DataSource {
 // CustomFormatConditionDataSource defines the custom format condition implementation.
 type CustomFormatConditionDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // CustomFormatCondition describes the custom format condition data model.
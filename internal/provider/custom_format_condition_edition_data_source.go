@@ -27,7 +27,7 @@ func NewCustomFormatConditionEditionDataSource() datasource.DataSource {
 // CustomFormatConditionEditionDataSource defines the custom format condition edition implementation.
 type CustomFormatConditionEditionDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *CustomFormatConditionEditionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
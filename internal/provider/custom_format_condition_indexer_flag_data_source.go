@@ -27,7 +27,7 @@ func NewCustomFormatConditionIndexerFlagDataSource() datasource.DataSource {
 // CustomFormatConditionIndexerFlagDataSource defines the custom format condition indexer flag implementation.
 type CustomFormatConditionIndexerFlagDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *CustomFormatConditionIndexerFlagDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
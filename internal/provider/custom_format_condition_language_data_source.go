@@ -27,7 +27,7 @@ func NewCustomFormatConditionLanguageDataSource() datasource.DataSource {
 // CustomFormatConditionLanguageDataSource defines the custom_format_condition_language implementation.
 type CustomFormatConditionLanguageDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *CustomFormatConditionLanguageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -27,7 +27,7 @@ func NewCustomFormatConditionQualityModifierDataSource() datasource.DataSource {
 // CustomFormatConditionQualityModifierDataSource defines the custom_format_condition_quality_modifier implementation.
 type CustomFormatConditionQualityModifierDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *CustomFormatConditionQualityModifierDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -27,7 +27,7 @@ func NewCustomFormatConditionReleaseGroupDataSource() datasource.DataSource {
 // CustomFormatConditionReleaseGroupDataSource defines the custom_format_condition_release_group implementation.
 type CustomFormatConditionReleaseGroupDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *CustomFormatConditionReleaseGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
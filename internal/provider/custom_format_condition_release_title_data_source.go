@@ -27,7 +27,7 @@ func NewCustomFormatConditionReleaseTitleDataSource() datasource.DataSource {
 // CustomFormatConditionReleaseTitleDataSource defines the custom_format_condition_release_title implementation.
 type CustomFormatConditionReleaseTitleDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *CustomFormatConditionReleaseTitleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
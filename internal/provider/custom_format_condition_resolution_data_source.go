@@ -27,7 +27,7 @@ func NewCustomFormatConditionResolutionDataSource() datasource.DataSource {
 // CustomFormatConditionResolutionDataSource defines the custom_format_condition_resolution implementation.
 type CustomFormatConditionResolutionDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *CustomFormatConditionResolutionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
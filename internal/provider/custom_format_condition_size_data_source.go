@@ -27,7 +27,7 @@ func NewCustomFormatConditionSizeDataSource() datasource.DataSource {
 // CustomFormatConditionSizeDataSource defines the custom_format_condition_size implementation.
 type CustomFormatConditionSizeDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *CustomFormatConditionSizeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -27,7 +27,7 @@ func NewCustomFormatConditionSourceDataSource() datasource.DataSource {
 // CustomFormatConditionSourceDataSource defines the custom_format_condition_source implementation.
 type CustomFormatConditionSourceDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *CustomFormatConditionSourceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
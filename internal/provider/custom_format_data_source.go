@@ -23,7 +23,7 @@ func NewCustomFormatDataSource() datasource.DataSource {
 // CustomFormatDataSource defines the custom_format implementation.
 type CustomFormatDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *CustomFormatDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -96,6 +96,8 @@ func (d *CustomFormatDataSource) Configure(ctx context.Context, req datasource.C
 }
 
 func (d *CustomFormatDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	var data *CustomFormat
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -104,7 +106,7 @@ func (d *CustomFormatDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 	// Get customFormat current value
-	response, _, err := d.client.CustomFormatAPI.ListCustomFormat(d.auth).Execute()
+	response, _, err := d.client.CustomFormatAPI.ListCustomFormat(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, customFormatDataSourceName, err))
 
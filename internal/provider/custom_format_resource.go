@@ -32,7 +32,7 @@ func NewCustomFormatResource() resource.Resource {
 // CustomFormatResource defines the custom format implementation.
 type CustomFormatResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // CustomFormat describes the custom format data model.
@@ -43,6 +43,12 @@ type CustomFormat struct {
 	IncludeCustomFormatWhenRenaming types.Bool   `tfsdk:"include_custom_format_when_renaming"`
 }
 
+// CustomFormatResourceModel describes the custom format resource data model.
+type CustomFormatResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	CustomFormat
+}
+
 func (c CustomFormat) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -61,6 +67,7 @@ func (r *CustomFormatResource) Schema(_ context.Context, _ resource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Profiles -->\nCustom Format resource.\nFor more information refer to [Custom Format](https://wiki.servarr.com/radarr/settings#custom-formats).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"include_custom_format_when_renaming": schema.BoolAttribute{
 				MarkdownDescription: "Include custom format when renaming flag.",
 				Optional:            true,
@@ -139,8 +146,11 @@ func (r *CustomFormatResource) Configure(ctx context.Context, req resource.Confi
 }
 
 func (r *CustomFormatResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var client *CustomFormat
+	var client *CustomFormatResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
 
@@ -151,9 +161,9 @@ func (r *CustomFormatResource) Create(ctx context.Context, req resource.CreateRe
 	// Create new CustomFormat
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.CustomFormatAPI.CreateCustomFormat(r.auth).CustomFormatResource(*request).Execute()
+	response, _, err := r.client.CustomFormatAPI.CreateCustomFormat(ctx).CustomFormatResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, customFormatResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, customFormatResourceName, err)
 
 		return
 	}
@@ -161,15 +171,19 @@ func (r *CustomFormatResource) Create(ctx context.Context, req resource.CreateRe
 	tflog.Trace(ctx, "created "+customFormatResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state CustomFormat
+	var state CustomFormatResourceModel
 
+	state.Timeouts = client.Timeouts
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *CustomFormatResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var client CustomFormat
+	var client CustomFormatResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &client)...)
 
@@ -178,8 +192,12 @@ func (r *CustomFormatResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get CustomFormat current value
-	response, _, err := r.client.CustomFormatAPI.GetCustomFormatById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.CustomFormatAPI.GetCustomFormatById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, customFormatResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, customFormatResourceName, err))
 
 		return
@@ -188,15 +206,19 @@ func (r *CustomFormatResource) Read(ctx context.Context, req resource.ReadReques
 	tflog.Trace(ctx, "read "+customFormatResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state CustomFormat
+	var state CustomFormatResourceModel
 
+	state.Timeouts = client.Timeouts
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *CustomFormatResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var client *CustomFormat
+	var client *CustomFormatResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
 
@@ -207,9 +229,9 @@ func (r *CustomFormatResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update CustomFormat
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.CustomFormatAPI.UpdateCustomFormat(r.auth, strconv.Itoa(int(request.GetId()))).CustomFormatResource(*request).Execute()
+	response, _, err := r.client.CustomFormatAPI.UpdateCustomFormat(ctx, strconv.Itoa(int(request.GetId()))).CustomFormatResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, customFormatResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, customFormatResourceName, err)
 
 		return
 	}
@@ -217,13 +239,17 @@ func (r *CustomFormatResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Trace(ctx, "updated "+customFormatResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state CustomFormat
+	var state CustomFormatResourceModel
 
+	state.Timeouts = client.Timeouts
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *CustomFormatResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -233,7 +259,7 @@ func (r *CustomFormatResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	// Delete CustomFormat current value
-	_, err := r.client.CustomFormatAPI.DeleteCustomFormat(r.auth, int32(ID)).Execute()
+	_, err := r.client.CustomFormatAPI.DeleteCustomFormat(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, customFormatResourceName, err))
 
@@ -245,10 +271,24 @@ func (r *CustomFormatResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *CustomFormatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), customFormatImportLookups(r.auth.apiContext(ctx), r.client), req, resp)
 	tflog.Trace(ctx, "imported "+customFormatResourceName+": "+req.ID)
 }
 
+// customFormatImportLookups resolves the custom format import identifiers.
+func customFormatImportLookups(ctx context.Context, client *radarr.APIClient) map[string]helpers.ImportLookup {
+	return map[string]helpers.ImportLookup{
+		"name=": func(name string) (int, error) {
+			formats, _, err := client.CustomFormatAPI.ListCustomFormat(ctx).Execute()
+			if err != nil {
+				return 0, err
+			}
+
+			return helpers.FindImportID(formats, name, (*radarr.CustomFormatResource).GetName)
+		},
+	}
+}
+
 func (c *CustomFormat) write(ctx context.Context, customFormat *radarr.CustomFormatResource, diags *diag.Diagnostics) {
 	var tempDiag diag.Diagnostics
 
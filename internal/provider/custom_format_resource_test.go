@@ -46,6 +46,13 @@ func TestAccCustomFormatResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "radarr_custom_format.test",
+				ImportStateId:     "name=resourceTest",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
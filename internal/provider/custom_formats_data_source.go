@@ -24,7 +24,7 @@ func NewCustomFormatsDataSource() datasource.DataSource {
 // CustomFormatsDataSource defines the custom formats implementation.
 type CustomFormatsDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // CustomFormats describes the custom formats data model.
@@ -115,8 +115,10 @@ func (d *CustomFormatsDataSource) Configure(ctx context.Context, req datasource.
 }
 
 func (d *CustomFormatsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get custom formatss current value
-	response, _, err := d.client.CustomFormatAPI.ListCustomFormat(d.auth).Execute()
+	response, _, err := d.client.CustomFormatAPI.ListCustomFormat(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, customFormatsDataSourceName, err))
 
@@ -25,7 +25,7 @@ func NewDelayProfileDataSource() datasource.DataSource {
 // DelayProfileDataSource defines the delay profile implementation.
 type DelayProfileDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *DelayProfileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -86,6 +86,8 @@ func (d *DelayProfileDataSource) Configure(ctx context.Context, req datasource.C
 }
 
 func (d *DelayProfileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	var data *DelayProfile
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -94,7 +96,7 @@ func (d *DelayProfileDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 	// Get delayprofiles current value
-	response, _, err := d.client.DelayProfileAPI.ListDelayProfile(d.auth).Execute()
+	response, _, err := d.client.DelayProfileAPI.ListDelayProfile(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, delayProfileDataSourceName, err))
 
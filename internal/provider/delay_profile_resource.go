@@ -34,7 +34,7 @@ func NewDelayProfileResource() resource.Resource {
 // DelayProfileResource defines the delay profile implementation.
 type DelayProfileResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // DelayProfile describes the delay profile data model.
@@ -50,6 +50,12 @@ type DelayProfile struct {
 	BypassIfHighestQuality types.Bool   `tfsdk:"bypass_if_highest_quality"`
 }
 
+// DelayProfileResourceModel describes the delay profile resource data model.
+type DelayProfileResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	DelayProfile
+}
+
 func (p DelayProfile) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -73,6 +79,7 @@ func (r *DelayProfileResource) Schema(_ context.Context, _ resource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Profiles -->\nDelay Profile resource.\nFor more information refer to [Delay Profiles](https://wiki.servarr.com/radarr/settings#delay-profiles) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Delay Profile ID.",
 				Computed:            true,
@@ -135,8 +142,11 @@ func (r *DelayProfileResource) Configure(ctx context.Context, req resource.Confi
 }
 
 func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var profile *DelayProfile
+	var profile *DelayProfileResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -148,9 +158,9 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 	request := profile.read(ctx, &resp.Diagnostics)
 
 	// Create new DelayProfile
-	response, _, err := r.client.DelayProfileAPI.CreateDelayProfile(r.auth).DelayProfileResource(*request).Execute()
+	response, _, err := r.client.DelayProfileAPI.CreateDelayProfile(ctx).DelayProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, delayProfileResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, delayProfileResourceName, err)
 
 		return
 	}
@@ -161,9 +171,9 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 	if !profile.Order.IsUnknown() {
 		response.Order = request.Order
 
-		response, _, err = r.client.DelayProfileAPI.UpdateDelayProfile(r.auth, strconv.Itoa(int(response.GetId()))).DelayProfileResource(*response).Execute()
+		response, _, err = r.client.DelayProfileAPI.UpdateDelayProfile(ctx, strconv.Itoa(int(response.GetId()))).DelayProfileResource(*response).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, delayProfileResourceName, err))
+			helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, delayProfileResourceName, err)
 
 			return
 		}
@@ -175,8 +185,11 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var profile *DelayProfile
+	var profile *DelayProfileResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &profile)...)
 
@@ -185,8 +198,12 @@ func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get delayprofile current value
-	response, _, err := r.client.DelayProfileAPI.GetDelayProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DelayProfileAPI.GetDelayProfileById(ctx, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, delayProfileResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, delayProfileResourceName, err))
 
 		return
@@ -199,8 +216,11 @@ func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var profile *DelayProfile
+	var profile *DelayProfileResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -212,9 +232,9 @@ func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRe
 	request := profile.read(ctx, &resp.Diagnostics)
 
 	// Update DelayProfile
-	response, _, err := r.client.DelayProfileAPI.UpdateDelayProfile(r.auth, strconv.Itoa(int(request.GetId()))).DelayProfileResource(*request).Execute()
+	response, _, err := r.client.DelayProfileAPI.UpdateDelayProfile(ctx, strconv.Itoa(int(request.GetId()))).DelayProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, delayProfileResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, delayProfileResourceName, err)
 
 		return
 	}
@@ -226,6 +246,9 @@ func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *DelayProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -235,7 +258,7 @@ func (r *DelayProfileResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	// Delete delayprofile current value
-	_, err := r.client.DelayProfileAPI.DeleteDelayProfile(r.auth, int32(ID)).Execute()
+	_, err := r.client.DelayProfileAPI.DeleteDelayProfile(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, delayProfileResourceName, err))
 
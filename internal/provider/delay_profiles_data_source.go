@@ -24,7 +24,7 @@ func NewDelayProfilesDataSource() datasource.DataSource {
 // DelayProfilesDataSource defines the delay profiles implementation.
 type DelayProfilesDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // DelayProfiles describes the delay profiles data model.
@@ -103,8 +103,10 @@ func (d *DelayProfilesDataSource) Configure(ctx context.Context, req datasource.
 }
 
 func (d *DelayProfilesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get delayprofiles current value
-	response, _, err := d.client.DelayProfileAPI.ListDelayProfile(d.auth).Execute()
+	response, _, err := d.client.DelayProfileAPI.ListDelayProfile(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, delayProfileResourceName, err))
 
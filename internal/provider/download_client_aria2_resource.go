@@ -36,12 +36,13 @@ func NewDownloadClientAria2Resource() resource.Resource {
 // DownloadClientAria2Resource defines the download client implementation.
 type DownloadClientAria2Resource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // DownloadClientAria2 describes the download client data model.
 type DownloadClientAria2 struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	Timeouts                 types.Object `tfsdk:"timeouts"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	RPCPath                  types.String `tfsdk:"rpc_path"`
@@ -98,6 +99,7 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Aria2 resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/radarr/settings#download-clients) and [Aria2](https://wiki.servarr.com/radarr/supported#aria2).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -174,6 +176,9 @@ func (r *DownloadClientAria2Resource) Configure(ctx context.Context, req resourc
 }
 
 func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var client *DownloadClientAria2
 
@@ -186,9 +191,9 @@ func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.C
 	// Create new DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientAria2ResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, downloadClientAria2ResourceName, err)
 
 		return
 	}
@@ -200,6 +205,9 @@ func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.C
 }
 
 func (r *DownloadClientAria2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var client DownloadClientAria2
 
@@ -210,8 +218,12 @@ func (r *DownloadClientAria2Resource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get DownloadClientAria2 current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, downloadClientAria2ResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientAria2ResourceName, err))
 
 		return
@@ -224,6 +236,9 @@ func (r *DownloadClientAria2Resource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var client *DownloadClientAria2
 
@@ -236,9 +251,9 @@ func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.U
 	// Update DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientAria2ResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, downloadClientAria2ResourceName, err)
 
 		return
 	}
@@ -250,6 +265,9 @@ func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.U
 }
 
 func (r *DownloadClientAria2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -259,7 +277,7 @@ func (r *DownloadClientAria2Resource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Delete DownloadClientAria2 current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientAria2ResourceName, err))
 
@@ -271,7 +289,7 @@ func (r *DownloadClientAria2Resource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *DownloadClientAria2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), downloadClientImportLookups(r.auth.apiContext(ctx), r.client, downloadClientAria2Implementation), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientAria2ResourceName+": "+req.ID)
 }
 
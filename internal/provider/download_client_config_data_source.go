@@ -22,7 +22,7 @@ func NewDownloadClientConfigDataSource() datasource.DataSource {
 // DownloadClientConfigDataSource defines the download client config implementation.
 type DownloadClientConfigDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *DownloadClientConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -66,8 +66,10 @@ func (d *DownloadClientConfigDataSource) Configure(ctx context.Context, req data
 }
 
 func (d *DownloadClientConfigDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get indexer config current value
-	response, _, err := d.client.DownloadClientConfigAPI.GetDownloadClientConfig(d.auth).Execute()
+	response, _, err := d.client.DownloadClientConfigAPI.GetDownloadClientConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientConfigDataSourceName, err))
 
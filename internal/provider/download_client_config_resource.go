@@ -30,7 +30,7 @@ func NewDownloadClientConfigResource() resource.Resource {
 // DownloadClientConfigResource defines the download client config implementation.
 type DownloadClientConfigResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // DownloadClientConfig describes the download client config data model.
@@ -42,6 +42,12 @@ type DownloadClientConfig struct {
 	AutoRedownloadFailed             types.Bool   `tfsdk:"auto_redownload_failed"`
 }
 
+// DownloadClientConfigResourceModel describes the download client config resource data model.
+type DownloadClientConfigResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	DownloadClientConfig
+}
+
 func (r *DownloadClientConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + downloadClientConfigResourceName
 }
@@ -50,6 +56,7 @@ func (r *DownloadClientConfigResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Config resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/radarr/settings#completed-download-handling) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client Config ID.",
 				Computed:            true,
@@ -85,8 +92,11 @@ func (r *DownloadClientConfigResource) Configure(ctx context.Context, req resour
 }
 
 func (r *DownloadClientConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var config *DownloadClientConfig
+	var config *DownloadClientConfigResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
 
@@ -99,9 +109,9 @@ func (r *DownloadClientConfigResource) Create(ctx context.Context, req resource.
 	request.SetId(1)
 
 	// Create new DownloadClientConfig
-	response, _, err := r.client.DownloadClientConfigAPI.UpdateDownloadClientConfig(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientConfigResource(*request).Execute()
+	response, _, err := r.client.DownloadClientConfigAPI.UpdateDownloadClientConfig(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientConfigResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, downloadClientConfigResourceName, err)
 
 		return
 	}
@@ -113,8 +123,11 @@ func (r *DownloadClientConfigResource) Create(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var config *DownloadClientConfig
+	var config *DownloadClientConfigResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
 
@@ -123,7 +136,7 @@ func (r *DownloadClientConfigResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get downloadClientConfig current value
-	response, _, err := r.client.DownloadClientConfigAPI.GetDownloadClientConfig(r.auth).Execute()
+	response, _, err := r.client.DownloadClientConfigAPI.GetDownloadClientConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientConfigResourceName, err))
 
@@ -137,8 +150,11 @@ func (r *DownloadClientConfigResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *DownloadClientConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var config *DownloadClientConfig
+	var config *DownloadClientConfigResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
 
@@ -150,9 +166,9 @@ func (r *DownloadClientConfigResource) Update(ctx context.Context, req resource.
 	request := config.read()
 
 	// Update DownloadClientConfig
-	response, _, err := r.client.DownloadClientConfigAPI.UpdateDownloadClientConfig(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientConfigResource(*request).Execute()
+	response, _, err := r.client.DownloadClientConfigAPI.UpdateDownloadClientConfig(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientConfigResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, downloadClientConfigResourceName, err)
 
 		return
 	}
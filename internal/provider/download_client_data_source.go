@@ -24,7 +24,7 @@ func NewDownloadClientDataSource() datasource.DataSource {
 // DownloadClientDataSource defines the download_client implementation.
 type DownloadClientDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *DownloadClientDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -250,6 +250,8 @@ func (d *DownloadClientDataSource) Configure(ctx context.Context, req datasource
 }
 
 func (d *DownloadClientDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	var data *DownloadClient
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -258,7 +260,7 @@ func (d *DownloadClientDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 	// Get downloadClient current value
-	response, _, err := d.client.DownloadClientAPI.ListDownloadClient(d.auth).Execute()
+	response, _, err := d.client.DownloadClientAPI.ListDownloadClient(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientDataSourceName, err))
 
@@ -36,7 +36,7 @@ func NewDownloadClientFloodResource() resource.Resource {
 // DownloadClientFloodResource defines the download client implementation.
 type DownloadClientFloodResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // DownloadClientFlood describes the download client data model.
@@ -45,6 +45,7 @@ type DownloadClientFlood struct {
 	FieldTags                types.Set    `tfsdk:"field_tags"`
 	AdditionalTags           types.Set    `tfsdk:"additional_tags"`
 	PostImportTags           types.Set    `tfsdk:"post_import_tags"`
+	Timeouts                 types.Object `tfsdk:"timeouts"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	URLBase                  types.String `tfsdk:"url_base"`
@@ -116,6 +117,7 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Flood resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/radarr/settings#download-clients) and [Flood](https://wiki.servarr.com/radarr/supported#flood).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -225,6 +227,9 @@ func (r *DownloadClientFloodResource) Configure(ctx context.Context, req resourc
 }
 
 func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var client *DownloadClientFlood
 
@@ -237,9 +242,9 @@ func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.C
 	// Create new DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientFloodResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, downloadClientFloodResourceName, err)
 
 		return
 	}
@@ -251,6 +256,9 @@ func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.C
 }
 
 func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var client DownloadClientFlood
 
@@ -261,8 +269,12 @@ func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get DownloadClientFlood current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, downloadClientFloodResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientFloodResourceName, err))
 
 		return
@@ -275,6 +287,9 @@ func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var client *DownloadClientFlood
 
@@ -287,9 +302,9 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 	// Update DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientFloodResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, downloadClientFloodResourceName, err)
 
 		return
 	}
@@ -301,6 +316,9 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *DownloadClientFloodResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -310,7 +328,7 @@ func (r *DownloadClientFloodResource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Delete DownloadClientFlood current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientFloodResourceName, err))
 
@@ -322,7 +340,7 @@ func (r *DownloadClientFloodResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *DownloadClientFloodResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), downloadClientImportLookups(r.auth.apiContext(ctx), r.client, downloadClientFloodImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientFloodResourceName+": "+req.ID)
 }
 
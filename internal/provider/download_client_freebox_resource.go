@@ -38,12 +38,13 @@ func NewDownloadClientFreeboxResource() resource.Resource {
 // DownloadClientFreeboxResource defines the download client implementation.
 type DownloadClientFreeboxResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // DownloadClientFreebox describes the download client data model.
 type DownloadClientFreebox struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	Timeouts                 types.Object `tfsdk:"timeouts"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	APIURL                   types.String `tfsdk:"api_url"`
@@ -118,6 +119,7 @@ func (r *DownloadClientFreeboxResource) Schema(_ context.Context, _ resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Freebox resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/radarr/settings#download-clients) and [Freebox](https://wiki.servarr.com/radarr/supported#torrentfreeboxdownload).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -225,6 +227,9 @@ func (r *DownloadClientFreeboxResource) Configure(ctx context.Context, req resou
 }
 
 func (r *DownloadClientFreeboxResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var client *DownloadClientFreebox
 
@@ -237,9 +242,9 @@ func (r *DownloadClientFreeboxResource) Create(ctx context.Context, req resource
 	// Create new DownloadClientFreebox
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientFreeboxResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, downloadClientFreeboxResourceName, err)
 
 		return
 	}
@@ -251,6 +256,9 @@ func (r *DownloadClientFreeboxResource) Create(ctx context.Context, req resource
 }
 
 func (r *DownloadClientFreeboxResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var client DownloadClientFreebox
 
@@ -261,8 +269,12 @@ func (r *DownloadClientFreeboxResource) Read(ctx context.Context, req resource.R
 	}
 
 	// Get DownloadClientFreebox current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, downloadClientFreeboxResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientFreeboxResourceName, err))
 
 		return
@@ -275,6 +287,9 @@ func (r *DownloadClientFreeboxResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *DownloadClientFreeboxResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var client *DownloadClientFreebox
 
@@ -287,9 +302,9 @@ func (r *DownloadClientFreeboxResource) Update(ctx context.Context, req resource
 	// Update DownloadClientFreebox
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientFreeboxResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, downloadClientFreeboxResourceName, err)
 
 		return
 	}
@@ -301,6 +316,9 @@ func (r *DownloadClientFreeboxResource) Update(ctx context.Context, req resource
 }
 
 func (r *DownloadClientFreeboxResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -310,7 +328,7 @@ func (r *DownloadClientFreeboxResource) Delete(ctx context.Context, req resource
 	}
 
 	// Delete DownloadClientFreebox current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientFreeboxResourceName, err))
 
@@ -322,7 +340,7 @@ func (r *DownloadClientFreeboxResource) Delete(ctx context.Context, req resource
 }
 
 func (r *DownloadClientFreeboxResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), downloadClientImportLookups(r.auth.apiContext(ctx), r.client, downloadClientFreeboxImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientFreeboxResourceName+": "+req.ID)
 }
 
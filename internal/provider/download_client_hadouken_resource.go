@@ -36,12 +36,13 @@ func NewDownloadClientHadoukenResource() resource.Resource {
 // DownloadClientHadoukenResource defines the download client implementation.
 type DownloadClientHadoukenResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // DownloadClientHadouken describes the download client data model.
 type DownloadClientHadouken struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	Timeouts                 types.Object `tfsdk:"timeouts"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	URLBase                  types.String `tfsdk:"url_base"`
@@ -104,6 +105,7 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Hadouken resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/radarr/settings#download-clients) and [Hadouken](https://wiki.servarr.com/radarr/supported#hadouken).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -188,6 +190,9 @@ func (r *DownloadClientHadoukenResource) Configure(ctx context.Context, req reso
 }
 
 func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var client *DownloadClientHadouken
 
@@ -200,9 +205,9 @@ func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resourc
 	// Create new DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientHadoukenResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, downloadClientHadoukenResourceName, err)
 
 		return
 	}
@@ -214,6 +219,9 @@ func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resourc
 }
 
 func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var client DownloadClientHadouken
 
@@ -224,8 +232,12 @@ func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.
 	}
 
 	// Get DownloadClientHadouken current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, downloadClientHadoukenResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientHadoukenResourceName, err))
 
 		return
@@ -238,6 +250,9 @@ func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var client *DownloadClientHadouken
 
@@ -250,9 +265,9 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 	// Update DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientHadoukenResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, downloadClientHadoukenResourceName, err)
 
 		return
 	}
@@ -264,6 +279,9 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 }
 
 func (r *DownloadClientHadoukenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -273,7 +291,7 @@ func (r *DownloadClientHadoukenResource) Delete(ctx context.Context, req resourc
 	}
 
 	// Delete DownloadClientHadouken current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientHadoukenResourceName, err))
 
@@ -285,7 +303,7 @@ func (r *DownloadClientHadoukenResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *DownloadClientHadoukenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), downloadClientImportLookups(r.auth.apiContext(ctx), r.client, downloadClientHadoukenImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientHadoukenResourceName+": "+req.ID)
 }
 
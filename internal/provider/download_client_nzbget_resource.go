@@ -38,12 +38,13 @@ func NewDownloadClientNzbgetResource() resource.Resource {
 // DownloadClientNzbgetResource defines the download client implementation.
 type DownloadClientNzbgetResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // DownloadClientNzbget describes the download client data model.
 type DownloadClientNzbget struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	Timeouts                 types.Object `tfsdk:"timeouts"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	URLBase                  types.String `tfsdk:"url_base"`
@@ -115,6 +116,7 @@ func (r *DownloadClientNzbgetResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client NZBGet resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/radarr/settings#download-clients) and [NZBGet](https://wiki.servarr.com/radarr/supported#nzbget).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -222,6 +224,9 @@ func (r *DownloadClientNzbgetResource) Configure(ctx context.Context, req resour
 }
 
 func (r *DownloadClientNzbgetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var client *DownloadClientNzbget
 
@@ -234,9 +239,9 @@ func (r *DownloadClientNzbgetResource) Create(ctx context.Context, req resource.
 	// Create new DownloadClientNzbget
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientNzbgetResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, downloadClientNzbgetResourceName, err)
 
 		return
 	}
@@ -248,6 +253,9 @@ func (r *DownloadClientNzbgetResource) Create(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientNzbgetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var client DownloadClientNzbget
 
@@ -258,8 +266,12 @@ func (r *DownloadClientNzbgetResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get DownloadClientNzbget current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, downloadClientNzbgetResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientNzbgetResourceName, err))
 
 		return
@@ -272,6 +284,9 @@ func (r *DownloadClientNzbgetResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *DownloadClientNzbgetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var client *DownloadClientNzbget
 
@@ -284,9 +299,9 @@ func (r *DownloadClientNzbgetResource) Update(ctx context.Context, req resource.
 	// Update DownloadClientNzbget
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientNzbgetResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, downloadClientNzbgetResourceName, err)
 
 		return
 	}
@@ -298,6 +313,9 @@ func (r *DownloadClientNzbgetResource) Update(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientNzbgetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -307,7 +325,7 @@ func (r *DownloadClientNzbgetResource) Delete(ctx context.Context, req resource.
 	}
 
 	// Delete DownloadClientNzbget current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientNzbgetResourceName, err))
 
@@ -319,7 +337,7 @@ func (r *DownloadClientNzbgetResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientNzbgetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), downloadClientImportLookups(r.auth.apiContext(ctx), r.client, downloadClientNzbgetImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientNzbgetResourceName+": "+req.ID)
 }
 
@@ -36,12 +36,13 @@ func NewDownloadClientPneumaticResource() resource.Resource {
 // DownloadClientPneumaticResource defines the download client implementation.
 type DownloadClientPneumaticResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // DownloadClientPneumatic describes the download client data model.
 type DownloadClientPneumatic struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	Timeouts                 types.Object `tfsdk:"timeouts"`
 	Name                     types.String `tfsdk:"name"`
 	NzbFolder                types.String `tfsdk:"nzb_folder"`
 	StrmFolder               types.String `tfsdk:"strm_folder"`
@@ -89,6 +90,7 @@ func (r *DownloadClientPneumaticResource) Schema(_ context.Context, _ resource.S
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Pneumatic resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/radarr/settings#download-clients) and [Pneumatic](https://wiki.servarr.com/radarr/supported#pneumatic).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -147,6 +149,9 @@ func (r *DownloadClientPneumaticResource) Configure(ctx context.Context, req res
 }
 
 func (r *DownloadClientPneumaticResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var client *DownloadClientPneumatic
 
@@ -159,9 +164,9 @@ func (r *DownloadClientPneumaticResource) Create(ctx context.Context, req resour
 	// Create new DownloadClientPneumatic
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientPneumaticResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, downloadClientPneumaticResourceName, err)
 
 		return
 	}
@@ -173,6 +178,9 @@ func (r *DownloadClientPneumaticResource) Create(ctx context.Context, req resour
 }
 
 func (r *DownloadClientPneumaticResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var client DownloadClientPneumatic
 
@@ -183,8 +191,12 @@ func (r *DownloadClientPneumaticResource) Read(ctx context.Context, req resource
 	}
 
 	// Get DownloadClientPneumatic current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, downloadClientPneumaticResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientPneumaticResourceName, err))
 
 		return
@@ -197,6 +209,9 @@ func (r *DownloadClientPneumaticResource) Read(ctx context.Context, req resource
 }
 
 func (r *DownloadClientPneumaticResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var client *DownloadClientPneumatic
 
@@ -209,9 +224,9 @@ func (r *DownloadClientPneumaticResource) Update(ctx context.Context, req resour
 	// Update DownloadClientPneumatic
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientPneumaticResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, downloadClientPneumaticResourceName, err)
 
 		return
 	}
@@ -223,6 +238,9 @@ func (r *DownloadClientPneumaticResource) Update(ctx context.Context, req resour
 }
 
 func (r *DownloadClientPneumaticResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -232,7 +250,7 @@ func (r *DownloadClientPneumaticResource) Delete(ctx context.Context, req resour
 	}
 
 	// Delete DownloadClientPneumatic current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientPneumaticResourceName, err))
 
@@ -244,7 +262,7 @@ func (r *DownloadClientPneumaticResource) Delete(ctx context.Context, req resour
 }
 
 func (r *DownloadClientPneumaticResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), downloadClientImportLookups(r.auth.apiContext(ctx), r.client, downloadClientPneumaticImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientPneumaticResourceName+": "+req.ID)
 }
 
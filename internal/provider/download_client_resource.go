@@ -44,7 +44,7 @@ func NewDownloadClientResource() resource.Resource {
 // DownloadClientResource defines the download client implementation.
 type DownloadClientResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // DownloadClient describes the download client data model.
@@ -100,6 +100,12 @@ type DownloadClient struct {
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
 }
 
+// DownloadClientResourceModel describes the download client resource data model.
+type DownloadClientResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	DownloadClient
+}
+
 func (d DownloadClient) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -163,6 +169,7 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nGeneric Download Client resource. When possible use a specific resource instead.\nFor more information refer to [Download Client](https://wiki.servarr.com/radarr/settings#download-clients).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -445,8 +452,11 @@ func (r *DownloadClientResource) Configure(ctx context.Context, req resource.Con
 }
 
 func (r *DownloadClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var client *DownloadClient
+	var client *DownloadClientResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
 
@@ -457,9 +467,9 @@ func (r *DownloadClientResource) Create(ctx context.Context, req resource.Create
 	// Create new DownloadClient
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, downloadClientResourceName, err)
 
 		return
 	}
@@ -467,16 +477,20 @@ func (r *DownloadClientResource) Create(ctx context.Context, req resource.Create
 	tflog.Trace(ctx, "created "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	var state DownloadClientResourceModel
 
-	state.writeSensitive(client)
+	state.Timeouts = client.Timeouts
+	state.writeSensitive(&client.DownloadClient)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var client *DownloadClient
+	var client *DownloadClientResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &client)...)
 
@@ -485,8 +499,12 @@ func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get DownloadClient current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, downloadClientResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientResourceName, err))
 
 		return
@@ -495,16 +513,20 @@ func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequ
 	tflog.Trace(ctx, "read "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	var state DownloadClientResourceModel
 
-	state.writeSensitive(client)
+	state.Timeouts = client.Timeouts
+	state.writeSensitive(&client.DownloadClient)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *DownloadClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var client *DownloadClient
+	var client *DownloadClientResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
 
@@ -515,9 +537,9 @@ func (r *DownloadClientResource) Update(ctx context.Context, req resource.Update
 	// Update DownloadClient
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, downloadClientResourceName, err)
 
 		return
 	}
@@ -525,14 +547,18 @@ func (r *DownloadClientResource) Update(ctx context.Context, req resource.Update
 	tflog.Trace(ctx, "updated "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	var state DownloadClientResourceModel
 
-	state.writeSensitive(client)
+	state.Timeouts = client.Timeouts
+	state.writeSensitive(&client.DownloadClient)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *DownloadClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -542,7 +568,7 @@ func (r *DownloadClientResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	// Delete DownloadClient current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientResourceName, err))
 
@@ -554,10 +580,24 @@ func (r *DownloadClientResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *DownloadClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), downloadClientImportLookups(r.auth.apiContext(ctx), r.client, ""), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientResourceName+": "+req.ID)
 }
 
+// downloadClientImportLookups resolves the download client import identifiers, only matching the given implementation if set.
+func downloadClientImportLookups(ctx context.Context, client *radarr.APIClient, implementation string) map[string]helpers.ImportLookup {
+	return map[string]helpers.ImportLookup{
+		"name=": func(name string) (int, error) {
+			clients, _, err := client.DownloadClientAPI.ListDownloadClient(ctx).Execute()
+			if err != nil {
+				return 0, err
+			}
+
+			return helpers.FindImportID(helpers.FilterImplementation(clients, implementation), name, (*radarr.DownloadClientResource).GetName)
+		},
+	}
+}
+
 func (d *DownloadClient) write(ctx context.Context, downloadClient *radarr.DownloadClientResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
@@ -36,12 +36,13 @@ func NewDownloadClientTorrentBlackholeResource() resource.Resource {
 // DownloadClientTorrentBlackholeResource defines the download client implementation.
 type DownloadClientTorrentBlackholeResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // DownloadClientTorrentBlackhole describes the download client data model.
 type DownloadClientTorrentBlackhole struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	Timeouts                 types.Object `tfsdk:"timeouts"`
 	Name                     types.String `tfsdk:"name"`
 	TorrentFolder            types.String `tfsdk:"torrent_folder"`
 	WatchFolder              types.String `tfsdk:"watch_folder"`
@@ -98,6 +99,7 @@ func (r *DownloadClientTorrentBlackholeResource) Schema(_ context.Context, _ res
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Torrent Blackhole resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/radarr/settings#download-clients) and [TorrentBlackhole](https://wiki.servarr.com/radarr/supported#torrentblackhole).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -171,6 +173,9 @@ func (r *DownloadClientTorrentBlackholeResource) Configure(ctx context.Context,
 }
 
 func (r *DownloadClientTorrentBlackholeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var client *DownloadClientTorrentBlackhole
 
@@ -183,9 +188,9 @@ func (r *DownloadClientTorrentBlackholeResource) Create(ctx context.Context, req
 	// Create new DownloadClientTorrentBlackhole
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientTorrentBlackholeResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, downloadClientTorrentBlackholeResourceName, err)
 
 		return
 	}
@@ -197,6 +202,9 @@ func (r *DownloadClientTorrentBlackholeResource) Create(ctx context.Context, req
 }
 
 func (r *DownloadClientTorrentBlackholeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var client DownloadClientTorrentBlackhole
 
@@ -207,8 +215,12 @@ func (r *DownloadClientTorrentBlackholeResource) Read(ctx context.Context, req r
 	}
 
 	// Get DownloadClientTorrentBlackhole current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, downloadClientTorrentBlackholeResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientTorrentBlackholeResourceName, err))
 
 		return
@@ -221,6 +233,9 @@ func (r *DownloadClientTorrentBlackholeResource) Read(ctx context.Context, req r
 }
 
 func (r *DownloadClientTorrentBlackholeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var client *DownloadClientTorrentBlackhole
 
@@ -233,9 +248,9 @@ func (r *DownloadClientTorrentBlackholeResource) Update(ctx context.Context, req
 	// Update DownloadClientTorrentBlackhole
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientTorrentBlackholeResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, downloadClientTorrentBlackholeResourceName, err)
 
 		return
 	}
@@ -247,6 +262,9 @@ func (r *DownloadClientTorrentBlackholeResource) Update(ctx context.Context, req
 }
 
 func (r *DownloadClientTorrentBlackholeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -256,7 +274,7 @@ func (r *DownloadClientTorrentBlackholeResource) Delete(ctx context.Context, req
 	}
 
 	// Delete DownloadClientTorrentBlackhole current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientTorrentBlackholeResourceName, err))
 
@@ -268,7 +286,7 @@ func (r *DownloadClientTorrentBlackholeResource) Delete(ctx context.Context, req
 }
 
 func (r *DownloadClientTorrentBlackholeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), downloadClientImportLookups(r.auth.apiContext(ctx), r.client, downloadClientTorrentBlackholeImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientTorrentBlackholeResourceName+": "+req.ID)
 }
 
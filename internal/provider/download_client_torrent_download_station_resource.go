@@ -36,12 +36,13 @@ func NewDownloadClientTorrentDownloadStationResource() resource.Resource {
 // DownloadClientTorrentDownloadStationResource defines the download client implementation.
 type DownloadClientTorrentDownloadStationResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // DownloadClientTorrentDownloadStation describes the download client data model.
 type DownloadClientTorrentDownloadStation struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	Timeouts                 types.Object `tfsdk:"timeouts"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	Username                 types.String `tfsdk:"username"`
@@ -98,6 +99,7 @@ func (r *DownloadClientTorrentDownloadStationResource) Schema(_ context.Context,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client TorrentDownloadStation resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/radarr/settings#download-clients) and [TorrentDownloadStation](https://wiki.servarr.com/radarr/supported#torrentdownloadstation).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -174,6 +176,9 @@ func (r *DownloadClientTorrentDownloadStationResource) Configure(ctx context.Con
 }
 
 func (r *DownloadClientTorrentDownloadStationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var client *DownloadClientTorrentDownloadStation
 
@@ -186,9 +191,9 @@ func (r *DownloadClientTorrentDownloadStationResource) Create(ctx context.Contex
 	// Create new DownloadClientTorrentDownloadStation
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientTorrentDownloadStationResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, downloadClientTorrentDownloadStationResourceName, err)
 
 		return
 	}
@@ -200,6 +205,9 @@ func (r *DownloadClientTorrentDownloadStationResource) Create(ctx context.Contex
 }
 
 func (r *DownloadClientTorrentDownloadStationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var client DownloadClientTorrentDownloadStation
 
@@ -210,8 +218,12 @@ func (r *DownloadClientTorrentDownloadStationResource) Read(ctx context.Context,
 	}
 
 	// Get DownloadClientTorrentDownloadStation current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, downloadClientTorrentDownloadStationResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientTorrentDownloadStationResourceName, err))
 
 		return
@@ -224,6 +236,9 @@ func (r *DownloadClientTorrentDownloadStationResource) Read(ctx context.Context,
 }
 
 func (r *DownloadClientTorrentDownloadStationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var client *DownloadClientTorrentDownloadStation
 
@@ -236,9 +251,9 @@ func (r *DownloadClientTorrentDownloadStationResource) Update(ctx context.Contex
 	// Update DownloadClientTorrentDownloadStation
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientTorrentDownloadStationResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, downloadClientTorrentDownloadStationResourceName, err)
 
 		return
 	}
@@ -250,6 +265,9 @@ func (r *DownloadClientTorrentDownloadStationResource) Update(ctx context.Contex
 }
 
 func (r *DownloadClientTorrentDownloadStationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -259,7 +277,7 @@ func (r *DownloadClientTorrentDownloadStationResource) Delete(ctx context.Contex
 	}
 
 	// Delete DownloadClientTorrentDownloadStation current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientTorrentDownloadStationResourceName, err))
 
@@ -271,7 +289,7 @@ func (r *DownloadClientTorrentDownloadStationResource) Delete(ctx context.Contex
 }
 
 func (r *DownloadClientTorrentDownloadStationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), downloadClientImportLookups(r.auth.apiContext(ctx), r.client, downloadClientTorrentDownloadStationImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientTorrentDownloadStationResourceName+": "+req.ID)
 }
 
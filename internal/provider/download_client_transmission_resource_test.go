@@ -47,6 +47,13 @@ func TestAccDownloadClientTransmissionResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "radarr_download_client_transmission.test",
+				ImportStateId:     "name=resourceTransmissionTest",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
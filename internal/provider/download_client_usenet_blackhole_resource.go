@@ -36,12 +36,13 @@ func NewDownloadClientUsenetBlackholeResource() resource.Resource {
 // DownloadClientUsenetBlackholeResource defines the download client implementation.
 type DownloadClientUsenetBlackholeResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // DownloadClientUsenetBlackhole describes the download client data model.
 type DownloadClientUsenetBlackhole struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	Timeouts                 types.Object `tfsdk:"timeouts"`
 	Name                     types.String `tfsdk:"name"`
 	NzbFolder                types.String `tfsdk:"nzb_folder"`
 	WatchFolder              types.String `tfsdk:"watch_folder"`
@@ -89,6 +90,7 @@ func (r *DownloadClientUsenetBlackholeResource) Schema(_ context.Context, _ reso
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Usenet Blackhole resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/radarr/settings#download-clients) and [UsenetBlackhole](https://wiki.servarr.com/radarr/supported#usenetblackhole).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -147,6 +149,9 @@ func (r *DownloadClientUsenetBlackholeResource) Configure(ctx context.Context, r
 }
 
 func (r *DownloadClientUsenetBlackholeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var client *DownloadClientUsenetBlackhole
 
@@ -159,9 +164,9 @@ func (r *DownloadClientUsenetBlackholeResource) Create(ctx context.Context, req
 	// Create new DownloadClientUsenetBlackhole
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientUsenetBlackholeResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, downloadClientUsenetBlackholeResourceName, err)
 
 		return
 	}
@@ -173,6 +178,9 @@ func (r *DownloadClientUsenetBlackholeResource) Create(ctx context.Context, req
 }
 
 func (r *DownloadClientUsenetBlackholeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var client DownloadClientUsenetBlackhole
 
@@ -183,8 +191,12 @@ func (r *DownloadClientUsenetBlackholeResource) Read(ctx context.Context, req re
 	}
 
 	// Get DownloadClientUsenetBlackhole current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, downloadClientUsenetBlackholeResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientUsenetBlackholeResourceName, err))
 
 		return
@@ -197,6 +209,9 @@ func (r *DownloadClientUsenetBlackholeResource) Read(ctx context.Context, req re
 }
 
 func (r *DownloadClientUsenetBlackholeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var client *DownloadClientUsenetBlackhole
 
@@ -209,9 +224,9 @@ func (r *DownloadClientUsenetBlackholeResource) Update(ctx context.Context, req
 	// Update DownloadClientUsenetBlackhole
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientUsenetBlackholeResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, downloadClientUsenetBlackholeResourceName, err)
 
 		return
 	}
@@ -223,6 +238,9 @@ func (r *DownloadClientUsenetBlackholeResource) Update(ctx context.Context, req
 }
 
 func (r *DownloadClientUsenetBlackholeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -232,7 +250,7 @@ func (r *DownloadClientUsenetBlackholeResource) Delete(ctx context.Context, req
 	}
 
 	// Delete DownloadClientUsenetBlackhole current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientUsenetBlackholeResourceName, err))
 
@@ -244,7 +262,7 @@ func (r *DownloadClientUsenetBlackholeResource) Delete(ctx context.Context, req
 }
 
 func (r *DownloadClientUsenetBlackholeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), downloadClientImportLookups(r.auth.apiContext(ctx), r.client, downloadClientUsenetBlackholeImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientUsenetBlackholeResourceName+": "+req.ID)
 }
 
@@ -36,12 +36,13 @@ func NewDownloadClientUsenetDownloadStationResource() resource.Resource {
 // DownloadClientUsenetDownloadStationResource defines the download client implementation.
 type DownloadClientUsenetDownloadStationResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // DownloadClientUsenetDownloadStation describes the download client data model.
 type DownloadClientUsenetDownloadStation struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	Timeouts                 types.Object `tfsdk:"timeouts"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	Username                 types.String `tfsdk:"username"`
@@ -98,6 +99,7 @@ func (r *DownloadClientUsenetDownloadStationResource) Schema(_ context.Context,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client UsenetDownloadStation resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/radarr/settings#download-clients) and [UsenetDownloadStation](https://wiki.servarr.com/radarr/supported#usenetdownloadstation).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -174,6 +176,9 @@ func (r *DownloadClientUsenetDownloadStationResource) Configure(ctx context.Cont
 }
 
 func (r *DownloadClientUsenetDownloadStationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var client *DownloadClientUsenetDownloadStation
 
@@ -186,9 +191,9 @@ func (r *DownloadClientUsenetDownloadStationResource) Create(ctx context.Context
 	// Create new DownloadClientUsenetDownloadStation
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientUsenetDownloadStationResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, downloadClientUsenetDownloadStationResourceName, err)
 
 		return
 	}
@@ -200,6 +205,9 @@ func (r *DownloadClientUsenetDownloadStationResource) Create(ctx context.Context
 }
 
 func (r *DownloadClientUsenetDownloadStationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var client DownloadClientUsenetDownloadStation
 
@@ -210,8 +218,12 @@ func (r *DownloadClientUsenetDownloadStationResource) Read(ctx context.Context,
 	}
 
 	// Get DownloadClientUsenetDownloadStation current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, downloadClientUsenetDownloadStationResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientUsenetDownloadStationResourceName, err))
 
 		return
@@ -224,6 +236,9 @@ func (r *DownloadClientUsenetDownloadStationResource) Read(ctx context.Context,
 }
 
 func (r *DownloadClientUsenetDownloadStationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var client *DownloadClientUsenetDownloadStation
 
@@ -236,9 +251,9 @@ func (r *DownloadClientUsenetDownloadStationResource) Update(ctx context.Context
 	// Update DownloadClientUsenetDownloadStation
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientUsenetDownloadStationResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, downloadClientUsenetDownloadStationResourceName, err)
 
 		return
 	}
@@ -250,6 +265,9 @@ func (r *DownloadClientUsenetDownloadStationResource) Update(ctx context.Context
 }
 
 func (r *DownloadClientUsenetDownloadStationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -259,7 +277,7 @@ func (r *DownloadClientUsenetDownloadStationResource) Delete(ctx context.Context
 	}
 
 	// Delete DownloadClientUsenetDownloadStation current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientUsenetDownloadStationResourceName, err))
 
@@ -271,7 +289,7 @@ func (r *DownloadClientUsenetDownloadStationResource) Delete(ctx context.Context
 }
 
 func (r *DownloadClientUsenetDownloadStationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), downloadClientImportLookups(r.auth.apiContext(ctx), r.client, downloadClientUsenetDownloadStationImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientUsenetDownloadStationResourceName+": "+req.ID)
 }
 
@@ -24,7 +24,7 @@ func NewDownloadClientsDataSource() datasource.DataSource {
 // DownloadClientsDataSource defines the download clients implementation.
 type DownloadClientsDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // DownloadClients describes the download clients data model.
@@ -268,8 +268,10 @@ func (d *DownloadClientsDataSource) Configure(ctx context.Context, req datasourc
 }
 
 func (d *DownloadClientsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get download clients current value
-	response, _, err := d.client.DownloadClientAPI.ListDownloadClient(d.auth).Execute()
+	response, _, err := d.client.DownloadClientAPI.ListDownloadClient(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, downloadClientsDataSourceName, err))
 
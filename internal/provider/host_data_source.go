@@ -23,7 +23,7 @@ func NewHostDataSource() datasource.DataSource {
 // HostDataSource defines the host implementation.
 type HostDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *HostDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -231,6 +231,8 @@ func (d *HostDataSource) Configure(ctx context.Context, req datasource.Configure
 }
 
 func (d *HostDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// assign default empty password value to empty string since it cannot be read
 	auth := AuthConfig{
 		Password: types.StringValue(""),
@@ -250,7 +252,7 @@ func (d *HostDataSource) Read(ctx context.Context, _ datasource.ReadRequest, res
 	}
 
 	// Get host current value
-	response, _, err := d.client.HostConfigAPI.GetHostConfig(d.auth).Execute()
+	response, _, err := d.client.HostConfigAPI.GetHostConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, hostDataSourceName, err))
 
@@ -39,7 +39,7 @@ func NewHostResource() resource.Resource {
 // HostResource defines the host implementation.
 type HostResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // Host describes the host data model.
@@ -59,6 +59,12 @@ type Host struct {
 	LaunchBrowser  types.Bool   `tfsdk:"launch_browser"`
 }
 
+// HostResourceModel describes the host resource data model.
+type HostResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	Host
+}
+
 // ProxyConfig is part of Host.
 type ProxyConfig struct {
 	Username             types.String `tfsdk:"username"`
@@ -185,6 +191,7 @@ func (r *HostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:System -->\nHost resource.\nFor more information refer to [Host](https://wiki.servarr.com/radarr/settings#general) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"launch_browser": schema.BoolAttribute{
 				MarkdownDescription: "Launch browser flag.",
 				Optional:            true,
@@ -411,8 +418,11 @@ func (r *HostResource) Configure(ctx context.Context, req resource.ConfigureRequ
 }
 
 func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var host *Host
+	var host *HostResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &host)...)
 
@@ -425,9 +435,9 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 	request.SetId(1)
 
 	// Create new Host
-	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(r.auth, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
+	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(ctx, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, hostResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, hostResourceName, err)
 
 		return
 	}
@@ -439,8 +449,11 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 }
 
 func (r *HostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var host *Host
+	var host *HostResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &host)...)
 
@@ -449,7 +462,7 @@ func (r *HostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Get host current value
-	response, _, err := r.client.HostConfigAPI.GetHostConfig(r.auth).Execute()
+	response, _, err := r.client.HostConfigAPI.GetHostConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, hostResourceName, err))
 
@@ -463,8 +476,11 @@ func (r *HostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var host *Host
+	var host *HostResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &host)...)
 
@@ -476,9 +492,9 @@ func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	request := host.read(ctx, &resp.Diagnostics)
 
 	// Update Host
-	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(r.auth, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
+	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(ctx, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, hostResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, hostResourceName, err)
 
 		return
 	}
@@ -712,3 +728,14 @@ func assignObjectValue(ctx context.Context, diags *diag.Diagnostics, dest *types
 
 	*dest = obj
 }
+
+// helper function to assign null object values.
+func assignNullObject(diags *diag.Diagnostics, dest *types.Object, name string, typ attr.Type) {
+	attrTypes := requireAttrTypes(diags, name, typ)
+
+	if diags.HasError() {
+		return
+	}
+
+	*dest = types.ObjectNull(attrTypes.AttributeTypes())
+}
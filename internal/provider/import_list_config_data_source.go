@@ -22,7 +22,7 @@ func NewImportListConfigDataSource() datasource.DataSource {
 // ImportListConfigDataSource defines the import list config implementation.
 type ImportListConfigDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *ImportListConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -54,8 +54,10 @@ func (d *ImportListConfigDataSource) Configure(ctx context.Context, req datasour
 }
 
 func (d *ImportListConfigDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get indexer config current value
-	response, _, err := d.client.ImportListConfigAPI.GetImportListConfig(d.auth).Execute()
+	response, _, err := d.client.ImportListConfigAPI.GetImportListConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListConfigDataSourceName, err))
 
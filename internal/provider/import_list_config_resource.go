@@ -32,7 +32,7 @@ func NewImportListConfigResource() resource.Resource {
 // ImportListConfigResource defines the import list config implementation.
 type ImportListConfigResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // ImportListConfig describes the import list config data model.
@@ -41,6 +41,12 @@ type ImportListConfig struct {
 	ID        types.Int64  `tfsdk:"id"`
 }
 
+// ImportListConfigResourceModel describes the import list config resource data model.
+type ImportListConfigResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	ImportListConfig
+}
+
 func (r *ImportListConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + importListConfigResourceName
 }
@@ -49,6 +55,7 @@ func (r *ImportListConfigResource) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImport List Config resource.\nFor more information refer to [Import List](https://wiki.servarr.com/radarr/settings#completed-download-handling) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List Config ID.",
 				Computed:            true,
@@ -75,8 +82,11 @@ func (r *ImportListConfigResource) Configure(ctx context.Context, req resource.C
 }
 
 func (r *ImportListConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var config *ImportListConfig
+	var config *ImportListConfigResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
 
@@ -89,9 +99,9 @@ func (r *ImportListConfigResource) Create(ctx context.Context, req resource.Crea
 	request.SetId(1)
 
 	// Create new ImportListConfig
-	response, _, err := r.client.ImportListConfigAPI.UpdateImportListConfig(r.auth, strconv.Itoa(int(request.GetId()))).ImportListConfigResource(*request).Execute()
+	response, _, err := r.client.ImportListConfigAPI.UpdateImportListConfig(ctx, strconv.Itoa(int(request.GetId()))).ImportListConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListConfigResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, importListConfigResourceName, err)
 
 		return
 	}
@@ -103,8 +113,11 @@ func (r *ImportListConfigResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *ImportListConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var config *ImportListConfig
+	var config *ImportListConfigResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
 
@@ -113,7 +126,7 @@ func (r *ImportListConfigResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get importListConfig current value
-	response, _, err := r.client.ImportListConfigAPI.GetImportListConfig(r.auth).Execute()
+	response, _, err := r.client.ImportListConfigAPI.GetImportListConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListConfigResourceName, err))
 
@@ -127,8 +140,11 @@ func (r *ImportListConfigResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *ImportListConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var config *ImportListConfig
+	var config *ImportListConfigResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
 
@@ -140,9 +156,9 @@ func (r *ImportListConfigResource) Update(ctx context.Context, req resource.Upda
 	request := config.read()
 
 	// Update ImportListConfig
-	response, _, err := r.client.ImportListConfigAPI.UpdateImportListConfig(r.auth, strconv.Itoa(int(request.GetId()))).ImportListConfigResource(*request).Execute()
+	response, _, err := r.client.ImportListConfigAPI.UpdateImportListConfig(ctx, strconv.Itoa(int(request.GetId()))).ImportListConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListConfigResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, importListConfigResourceName, err)
 
 		return
 	}
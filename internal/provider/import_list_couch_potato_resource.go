@@ -38,12 +38,13 @@ func NewImportListCouchPotatoResource() resource.Resource {
 // ImportListCouchPotatoResource defines the import list implementation.
 type ImportListCouchPotatoResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // ImportListCouchPotato describes the import list data model.
 type ImportListCouchPotato struct {
 	Tags                types.Set    `tfsdk:"tags"`
+	Timeouts            types.Object `tfsdk:"timeouts"`
 	Name                types.String `tfsdk:"name"`
 	Monitor             types.String `tfsdk:"monitor"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
@@ -112,6 +113,7 @@ func (r *ImportListCouchPotatoResource) Schema(_ context.Context, _ resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImport List Couch Potato resource.\nFor more information refer to [Import List](https://wiki.servarr.com/radarr/settings#import-lists) and [Couch Potato](https://wiki.servarr.com/radarr/supported#couchpotatoimport).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -206,6 +208,9 @@ func (r *ImportListCouchPotatoResource) Configure(ctx context.Context, req resou
 }
 
 func (r *ImportListCouchPotatoResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var importList *ImportListCouchPotato
 
@@ -218,9 +223,9 @@ func (r *ImportListCouchPotatoResource) Create(ctx context.Context, req resource
 	// Create new ImportListCouchPotato
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListCouchPotatoResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, importListCouchPotatoResourceName, err)
 
 		return
 	}
@@ -232,6 +237,9 @@ func (r *ImportListCouchPotatoResource) Create(ctx context.Context, req resource
 }
 
 func (r *ImportListCouchPotatoResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var importList *ImportListCouchPotato
 
@@ -242,8 +250,12 @@ func (r *ImportListCouchPotatoResource) Read(ctx context.Context, req resource.R
 	}
 
 	// Get ImportListCouchPotato current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, importListCouchPotatoResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListCouchPotatoResourceName, err))
 
 		return
@@ -256,6 +268,9 @@ func (r *ImportListCouchPotatoResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *ImportListCouchPotatoResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var importList *ImportListCouchPotato
 
@@ -268,9 +283,9 @@ func (r *ImportListCouchPotatoResource) Update(ctx context.Context, req resource
 	// Update ImportListCouchPotato
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListCouchPotatoResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, importListCouchPotatoResourceName, err)
 
 		return
 	}
@@ -282,6 +297,9 @@ func (r *ImportListCouchPotatoResource) Update(ctx context.Context, req resource
 }
 
 func (r *ImportListCouchPotatoResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -291,7 +309,7 @@ func (r *ImportListCouchPotatoResource) Delete(ctx context.Context, req resource
 	}
 
 	// Delete ImportListCouchPotato current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListCouchPotatoResourceName, err))
 
@@ -303,7 +321,7 @@ func (r *ImportListCouchPotatoResource) Delete(ctx context.Context, req resource
 }
 
 func (r *ImportListCouchPotatoResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), importListImportLookups(r.auth.apiContext(ctx), r.client, importListCouchPotatoImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+importListCouchPotatoResourceName+": "+req.ID)
 }
 
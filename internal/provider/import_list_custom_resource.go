@@ -38,12 +38,13 @@ func NewImportListCustomResource() resource.Resource {
 // ImportListCustomResource defines the import list implementation.
 type ImportListCustomResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // ImportListCustom describes the import list data model.
 type ImportListCustom struct {
 	Tags                types.Set    `tfsdk:"tags"`
+	Timeouts            types.Object `tfsdk:"timeouts"`
 	Name                types.String `tfsdk:"name"`
 	Monitor             types.String `tfsdk:"monitor"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
@@ -100,6 +101,7 @@ func (r *ImportListCustomResource) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImport List Custom resource.\nFor more information refer to [Import List](https://wiki.servarr.com/radarr/settings#import-lists) and [Custom List](https://wiki.servarr.com/radarr/supported#radarrlistimport).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -176,6 +178,9 @@ func (r *ImportListCustomResource) Configure(ctx context.Context, req resource.C
 }
 
 func (r *ImportListCustomResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var importList *ImportListCustom
 
@@ -188,9 +193,9 @@ func (r *ImportListCustomResource) Create(ctx context.Context, req resource.Crea
 	// Create new ImportListCustom
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListCustomResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, importListCustomResourceName, err)
 
 		return
 	}
@@ -202,6 +207,9 @@ func (r *ImportListCustomResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *ImportListCustomResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var importList *ImportListCustom
 
@@ -212,8 +220,12 @@ func (r *ImportListCustomResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get ImportListCustom current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, importListCustomResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListCustomResourceName, err))
 
 		return
@@ -226,6 +238,9 @@ func (r *ImportListCustomResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *ImportListCustomResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var importList *ImportListCustom
 
@@ -238,9 +253,9 @@ func (r *ImportListCustomResource) Update(ctx context.Context, req resource.Upda
 	// Update ImportListCustom
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListCustomResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, importListCustomResourceName, err)
 
 		return
 	}
@@ -252,6 +267,9 @@ func (r *ImportListCustomResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *ImportListCustomResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -261,7 +279,7 @@ func (r *ImportListCustomResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	// Delete ImportListCustom current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListCustomResourceName, err))
 
@@ -273,7 +291,7 @@ func (r *ImportListCustomResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *ImportListCustomResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), importListImportLookups(r.auth.apiContext(ctx), r.client, importListCustomImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+importListCustomResourceName+": "+req.ID)
 }
 
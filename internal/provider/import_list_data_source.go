@@ -24,7 +24,7 @@ func NewImportListDataSource() datasource.DataSource {
 // ImportListDataSource defines the import_list implementation.
 type ImportListDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *ImportListDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -279,6 +279,8 @@ func (d *ImportListDataSource) Configure(ctx context.Context, req datasource.Con
 }
 
 func (d *ImportListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	var data *ImportList
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -287,7 +289,7 @@ func (d *ImportListDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 	// Get importList current value
-	response, _, err := d.client.ImportListAPI.ListImportList(d.auth).Execute()
+	response, _, err := d.client.ImportListAPI.ListImportList(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListDataSourceName, err))
 
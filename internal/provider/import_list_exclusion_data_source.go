@@ -24,7 +24,7 @@ func NewImportListExclusionDataSource() datasource.DataSource {
 // ImportListExclusionDataSource defines the importListExclusion implementation.
 type ImportListExclusionDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *ImportListExclusionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -63,6 +63,8 @@ func (d *ImportListExclusionDataSource) Configure(ctx context.Context, req datas
 }
 
 func (d *ImportListExclusionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	var data *ImportListExclusion
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -72,7 +74,7 @@ func (d *ImportListExclusionDataSource) Read(ctx context.Context, req datasource
 	}
 
 	// Get importListExclusions current value
-	response, _, err := d.client.ImportListExclusionAPI.ListExclusions(d.auth).Execute()
+	response, _, err := d.client.ImportListExclusionAPI.ListExclusions(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListExclusionDataSourceName, err))
 
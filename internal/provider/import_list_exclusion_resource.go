@@ -31,7 +31,7 @@ func NewImportListExclusionResource() resource.Resource {
 // ImportListExclusionResource defines the importListExclusion implementation.
 type ImportListExclusionResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // ImportListExclusion describes the importListExclusion data model.
@@ -42,6 +42,12 @@ type ImportListExclusion struct {
 	ID     types.Int64  `tfsdk:"id"`
 }
 
+// ImportListExclusionResourceModel describes the importListExclusion resource data model.
+type ImportListExclusionResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	ImportListExclusion
+}
+
 func (i ImportListExclusion) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -60,6 +66,7 @@ func (r *ImportListExclusionResource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImport List Exclusion resource.\nFor more information refer to [ImportListExclusions](https://wiki.servarr.com/radarr/settings#list-exclusions) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"tmdb_id": schema.Int64Attribute{
 				MarkdownDescription: "Movie TMDB ID.",
 				Required:            true,
@@ -91,8 +98,11 @@ func (r *ImportListExclusionResource) Configure(ctx context.Context, req resourc
 }
 
 func (r *ImportListExclusionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var importListExclusion *ImportListExclusion
+	var importListExclusion *ImportListExclusionResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importListExclusion)...)
 
@@ -103,9 +113,9 @@ func (r *ImportListExclusionResource) Create(ctx context.Context, req resource.C
 	// Create new ImportListExclusion
 	request := importListExclusion.read()
 
-	response, _, err := r.client.ImportListExclusionAPI.CreateExclusions(r.auth).ImportListExclusionResource(*request).Execute()
+	response, _, err := r.client.ImportListExclusionAPI.CreateExclusions(ctx).ImportListExclusionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListExclusionResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, importListExclusionResourceName, err)
 
 		return
 	}
@@ -117,8 +127,11 @@ func (r *ImportListExclusionResource) Create(ctx context.Context, req resource.C
 }
 
 func (r *ImportListExclusionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var importListExclusion *ImportListExclusion
+	var importListExclusion *ImportListExclusionResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &importListExclusion)...)
 
@@ -127,8 +140,12 @@ func (r *ImportListExclusionResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get importListExclusion current value
-	response, _, err := r.client.ImportListExclusionAPI.GetExclusionsById(r.auth, int32(importListExclusion.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListExclusionAPI.GetExclusionsById(ctx, int32(importListExclusion.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, importListExclusionResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListExclusionResourceName, err))
 
 		return
@@ -141,8 +158,11 @@ func (r *ImportListExclusionResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *ImportListExclusionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var importListExclusion *ImportListExclusion
+	var importListExclusion *ImportListExclusionResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importListExclusion)...)
 
@@ -153,9 +173,9 @@ func (r *ImportListExclusionResource) Update(ctx context.Context, req resource.U
 	// Update ImportListExclusion
 	request := importListExclusion.read()
 
-	response, _, err := r.client.ImportListExclusionAPI.UpdateExclusions(r.auth, strconv.Itoa(int(request.GetId()))).ImportListExclusionResource(*request).Execute()
+	response, _, err := r.client.ImportListExclusionAPI.UpdateExclusions(ctx, strconv.Itoa(int(request.GetId()))).ImportListExclusionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListExclusionResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, importListExclusionResourceName, err)
 
 		return
 	}
@@ -167,6 +187,9 @@ func (r *ImportListExclusionResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *ImportListExclusionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -176,7 +199,7 @@ func (r *ImportListExclusionResource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Delete importListExclusion current value
-	_, err := r.client.ImportListExclusionAPI.DeleteExclusions(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListExclusionAPI.DeleteExclusions(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListExclusionResourceName, err))
 
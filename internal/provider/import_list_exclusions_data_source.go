@@ -24,7 +24,7 @@ func NewImportListExclusionsDataSource() datasource.DataSource {
 // ImportListExclusionsDataSource defines the importListExclusions implementation.
 type ImportListExclusionsDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // ImportListExclusions describes the importListExclusions data model.
@@ -81,8 +81,10 @@ func (d *ImportListExclusionsDataSource) Configure(ctx context.Context, req data
 }
 
 func (d *ImportListExclusionsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get importListExclusions current value
-	response, _, err := d.client.ImportListExclusionAPI.ListExclusions(d.auth).Execute()
+	response, _, err := d.client.ImportListExclusionAPI.ListExclusions(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListExclusionsDataSourceName, err))
 
@@ -38,12 +38,13 @@ func NewImportListIMDBResource() resource.Resource {
 // ImportListIMDBResource defines the import list implementation.
 type ImportListIMDBResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // ImportListIMDB describes the import list data model.
 type ImportListIMDB struct {
 	Tags                types.Set    `tfsdk:"tags"`
+	Timeouts            types.Object `tfsdk:"timeouts"`
 	Name                types.String `tfsdk:"name"`
 	Monitor             types.String `tfsdk:"monitor"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
@@ -100,6 +101,7 @@ func (r *ImportListIMDBResource) Schema(_ context.Context, _ resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImport List IMDB resource.\nFor more information refer to [Import List](https://wiki.servarr.com/radarr/settings#import-lists) and [IMDB](https://wiki.servarr.com/radarr/supported#imdblistimport).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -176,6 +178,9 @@ func (r *ImportListIMDBResource) Configure(ctx context.Context, req resource.Con
 }
 
 func (r *ImportListIMDBResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var importList *ImportListIMDB
 
@@ -188,9 +193,9 @@ func (r *ImportListIMDBResource) Create(ctx context.Context, req resource.Create
 	// Create new ImportListIMDB
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListIMDBResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, importListIMDBResourceName, err)
 
 		return
 	}
@@ -202,6 +207,9 @@ func (r *ImportListIMDBResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *ImportListIMDBResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var importList *ImportListIMDB
 
@@ -212,8 +220,12 @@ func (r *ImportListIMDBResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get ImportListIMDB current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, importListIMDBResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListIMDBResourceName, err))
 
 		return
@@ -226,6 +238,9 @@ func (r *ImportListIMDBResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *ImportListIMDBResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var importList *ImportListIMDB
 
@@ -238,9 +253,9 @@ func (r *ImportListIMDBResource) Update(ctx context.Context, req resource.Update
 	// Update ImportListIMDB
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListIMDBResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, importListIMDBResourceName, err)
 
 		return
 	}
@@ -252,6 +267,9 @@ func (r *ImportListIMDBResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *ImportListIMDBResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -261,7 +279,7 @@ func (r *ImportListIMDBResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	// Delete ImportListIMDB current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListIMDBResourceName, err))
 
@@ -273,7 +291,7 @@ func (r *ImportListIMDBResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *ImportListIMDBResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), importListImportLookups(r.auth.apiContext(ctx), r.client, importListIMDBImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+importListIMDBResourceName+": "+req.ID)
 }
 
@@ -38,12 +38,13 @@ func NewImportListPlexResource() resource.Resource {
 // ImportListPlexResource defines the import list implementation.
 type ImportListPlexResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // ImportListPlex describes the import list data model.
 type ImportListPlex struct {
 	Tags                types.Set    `tfsdk:"tags"`
+	Timeouts            types.Object `tfsdk:"timeouts"`
 	Name                types.String `tfsdk:"name"`
 	Monitor             types.String `tfsdk:"monitor"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
@@ -100,6 +101,7 @@ func (r *ImportListPlexResource) Schema(_ context.Context, _ resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImport List Plex resource.\nFor more information refer to [Import List](https://wiki.servarr.com/radarr/settings#import-lists) and [Plex](https://wiki.servarr.com/radarr/supported#pleximport).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -177,6 +179,9 @@ func (r *ImportListPlexResource) Configure(ctx context.Context, req resource.Con
 }
 
 func (r *ImportListPlexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var importList *ImportListPlex
 
@@ -189,9 +194,9 @@ func (r *ImportListPlexResource) Create(ctx context.Context, req resource.Create
 	// Create new ImportListPlex
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListPlexResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, importListPlexResourceName, err)
 
 		return
 	}
@@ -203,6 +208,9 @@ func (r *ImportListPlexResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *ImportListPlexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var importList *ImportListPlex
 
@@ -213,8 +221,12 @@ func (r *ImportListPlexResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get ImportListPlex current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, importListPlexResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListPlexResourceName, err))
 
 		return
@@ -227,6 +239,9 @@ func (r *ImportListPlexResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *ImportListPlexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var importList *ImportListPlex
 
@@ -239,9 +254,9 @@ func (r *ImportListPlexResource) Update(ctx context.Context, req resource.Update
 	// Update ImportListPlex
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListPlexResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, importListPlexResourceName, err)
 
 		return
 	}
@@ -253,6 +268,9 @@ func (r *ImportListPlexResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *ImportListPlexResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -262,7 +280,7 @@ func (r *ImportListPlexResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	// Delete ImportListPlex current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListPlexResourceName, err))
 
@@ -274,7 +292,7 @@ func (r *ImportListPlexResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *ImportListPlexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), importListImportLookups(r.auth.apiContext(ctx), r.client, importListPlexImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+importListPlexResourceName+": "+req.ID)
 }
 
@@ -38,7 +38,7 @@ func NewImportListRadarrResource() resource.Resource {
 // ImportListRadarrResource defines the import list implementation.
 type ImportListRadarrResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // ImportListRadarr describes the import list data model.
@@ -46,6 +46,7 @@ type ImportListRadarr struct {
 	ProfileIDs          types.Set    `tfsdk:"profile_ids"`
 	TagIDs              types.Set    `tfsdk:"tag_ids"`
 	Tags                types.Set    `tfsdk:"tags"`
+	Timeouts            types.Object `tfsdk:"timeouts"`
 	Name                types.String `tfsdk:"name"`
 	Monitor             types.String `tfsdk:"monitor"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
@@ -109,6 +110,7 @@ func (r *ImportListRadarrResource) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImport List Radarr resource.\nFor more information refer to [Import List](https://wiki.servarr.com/radarr/settings#import-lists) and [Radarr](https://wiki.servarr.com/radarr/supported#radarrimport).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -202,6 +204,9 @@ func (r *ImportListRadarrResource) Configure(ctx context.Context, req resource.C
 }
 
 func (r *ImportListRadarrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var importList *ImportListRadarr
 
@@ -214,9 +219,9 @@ func (r *ImportListRadarrResource) Create(ctx context.Context, req resource.Crea
 	// Create new ImportListRadarr
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListRadarrResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, importListRadarrResourceName, err)
 
 		return
 	}
@@ -228,6 +233,9 @@ func (r *ImportListRadarrResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *ImportListRadarrResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var importList *ImportListRadarr
 
@@ -238,8 +246,12 @@ func (r *ImportListRadarrResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get ImportListRadarr current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, importListRadarrResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListRadarrResourceName, err))
 
 		return
@@ -252,6 +264,9 @@ func (r *ImportListRadarrResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *ImportListRadarrResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var importList *ImportListRadarr
 
@@ -264,9 +279,9 @@ func (r *ImportListRadarrResource) Update(ctx context.Context, req resource.Upda
 	// Update ImportListRadarr
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListRadarrResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, importListRadarrResourceName, err)
 
 		return
 	}
@@ -278,6 +293,9 @@ func (r *ImportListRadarrResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *ImportListRadarrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -287,7 +305,7 @@ func (r *ImportListRadarrResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	// Delete ImportListRadarr current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListRadarrResourceName, err))
 
@@ -299,7 +317,7 @@ func (r *ImportListRadarrResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *ImportListRadarrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), importListImportLookups(r.auth.apiContext(ctx), r.client, importListRadarrImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+importListRadarrResourceName+": "+req.ID)
 }
 
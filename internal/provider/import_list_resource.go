@@ -43,7 +43,7 @@ func NewImportListResource() resource.Resource {
 // ImportListResource defines the import list implementation.
 type ImportListResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // ImportList describes the import list data model.
@@ -106,6 +106,12 @@ type ImportList struct {
 	PersonCastWriting         types.Bool   `tfsdk:"cast_writing"`
 }
 
+// ImportListResourceModel describes the import list resource data model.
+type ImportListResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	ImportList
+}
+
 func (i ImportList) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -176,6 +182,7 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nGeneric Import List resource. When possible use a specific resource instead.\nFor more information refer to [Import List](https://wiki.servarr.com/radarr/settings#import-lists).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -479,8 +486,11 @@ func (r *ImportListResource) Configure(ctx context.Context, req resource.Configu
 }
 
 func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var importList *ImportList
+	var importList *ImportListResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
 
@@ -491,9 +501,9 @@ func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequ
 	// Create new ImportList
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, importListResourceName, err)
 
 		return
 	}
@@ -501,16 +511,20 @@ func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequ
 	tflog.Trace(ctx, "created "+importListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state ImportList
+	var state ImportListResourceModel
 
-	state.writeSensitive(importList)
+	state.Timeouts = importList.Timeouts
+	state.writeSensitive(&importList.ImportList)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var importList *ImportList
+	var importList *ImportListResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &importList)...)
 
@@ -519,8 +533,12 @@ func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get ImportList current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, importListResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListResourceName, err))
 
 		return
@@ -529,16 +547,20 @@ func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest,
 	tflog.Trace(ctx, "read "+importListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state ImportList
+	var state ImportListResourceModel
 
-	state.writeSensitive(importList)
+	state.Timeouts = importList.Timeouts
+	state.writeSensitive(&importList.ImportList)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var importList *ImportList
+	var importList *ImportListResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
 
@@ -549,9 +571,9 @@ func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Update ImportList
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, importListResourceName, err)
 
 		return
 	}
@@ -559,14 +581,18 @@ func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequ
 	tflog.Trace(ctx, "updated "+importListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state ImportList
+	var state ImportListResourceModel
 
-	state.writeSensitive(importList)
+	state.Timeouts = importList.Timeouts
+	state.writeSensitive(&importList.ImportList)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *ImportListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -576,7 +602,7 @@ func (r *ImportListResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	// Delete ImportList current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListResourceName, err))
 
@@ -588,10 +614,24 @@ func (r *ImportListResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *ImportListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), importListImportLookups(r.auth.apiContext(ctx), r.client, ""), req, resp)
 	tflog.Trace(ctx, "imported "+importListResourceName+": "+req.ID)
 }
 
+// importListImportLookups resolves the import list import identifiers, only matching the given implementation if set.
+func importListImportLookups(ctx context.Context, client *radarr.APIClient, implementation string) map[string]helpers.ImportLookup {
+	return map[string]helpers.ImportLookup{
+		"name=": func(name string) (int, error) {
+			lists, _, err := client.ImportListAPI.ListImportList(ctx).Execute()
+			if err != nil {
+				return 0, err
+			}
+
+			return helpers.FindImportID(helpers.FilterImplementation(lists, implementation), name, (*radarr.ImportListResource).GetName)
+		},
+	}
+}
+
 func (i *ImportList) write(ctx context.Context, importList *radarr.ImportListResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
@@ -38,12 +38,13 @@ func NewImportListRSSResource() resource.Resource {
 // ImportListRSSResource defines the import list implementation.
 type ImportListRSSResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // ImportListRSS describes the import list data model.
 type ImportListRSS struct {
 	Tags                types.Set    `tfsdk:"tags"`
+	Timeouts            types.Object `tfsdk:"timeouts"`
 	Name                types.String `tfsdk:"name"`
 	Monitor             types.String `tfsdk:"monitor"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
@@ -100,6 +101,7 @@ func (r *ImportListRSSResource) Schema(_ context.Context, _ resource.SchemaReque
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImport List RSS resource.\nFor more information refer to [Import List](https://wiki.servarr.com/radarr/settings#import-lists) and [RSS](https://wiki.servarr.com/radarr/supported#rssimport).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -176,6 +178,9 @@ func (r *ImportListRSSResource) Configure(ctx context.Context, req resource.Conf
 }
 
 func (r *ImportListRSSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var importList *ImportListRSS
 
@@ -188,9 +193,9 @@ func (r *ImportListRSSResource) Create(ctx context.Context, req resource.CreateR
 	// Create new ImportListRSS
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListRSSResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, importListRSSResourceName, err)
 
 		return
 	}
@@ -202,6 +207,9 @@ func (r *ImportListRSSResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *ImportListRSSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var importList *ImportListRSS
 
@@ -212,8 +220,12 @@ func (r *ImportListRSSResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Get ImportListRSS current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, importListRSSResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListRSSResourceName, err))
 
 		return
@@ -226,6 +238,9 @@ func (r *ImportListRSSResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *ImportListRSSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var importList *ImportListRSS
 
@@ -238,9 +253,9 @@ func (r *ImportListRSSResource) Update(ctx context.Context, req resource.UpdateR
 	// Update ImportListRSS
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListRSSResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, importListRSSResourceName, err)
 
 		return
 	}
@@ -252,6 +267,9 @@ func (r *ImportListRSSResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *ImportListRSSResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -261,7 +279,7 @@ func (r *ImportListRSSResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	// Delete ImportListRSS current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListRSSResourceName, err))
 
@@ -273,7 +291,7 @@ func (r *ImportListRSSResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *ImportListRSSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), importListImportLookups(r.auth.apiContext(ctx), r.client, importListRSSImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+importListRSSResourceName+": "+req.ID)
 }
 
@@ -47,6 +47,13 @@ func TestAccImportListRssResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "radarr_import_list_rss.test",
+				ImportStateId:     "name=resourceRssTest",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
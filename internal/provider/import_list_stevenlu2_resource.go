@@ -39,12 +39,13 @@ func NewImportListStevenlu2Resource() resource.Resource {
 // ImportListStevenlu2Resource defines the import list implementation.
 type ImportListStevenlu2Resource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // ImportListStevenlu2 describes the import list data model.
 type ImportListStevenlu2 struct {
 	Tags                types.Set    `tfsdk:"tags"`
+	Timeouts            types.Object `tfsdk:"timeouts"`
 	Name                types.String `tfsdk:"name"`
 	Monitor             types.String `tfsdk:"monitor"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
@@ -104,6 +105,7 @@ func (r *ImportListStevenlu2Resource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImport List Stevenlu2 resource.\nFor more information refer to [Import List](https://wiki.servarr.com/radarr/settings#import-lists) and [Stevenlu2](https://wiki.servarr.com/radarr/supported#stevenlu2import).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -187,6 +189,9 @@ func (r *ImportListStevenlu2Resource) Configure(ctx context.Context, req resourc
 }
 
 func (r *ImportListStevenlu2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var importList *ImportListStevenlu2
 
@@ -199,9 +204,9 @@ func (r *ImportListStevenlu2Resource) Create(ctx context.Context, req resource.C
 	// Create new ImportListStevenlu2
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListStevenlu2ResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, importListStevenlu2ResourceName, err)
 
 		return
 	}
@@ -213,6 +218,9 @@ func (r *ImportListStevenlu2Resource) Create(ctx context.Context, req resource.C
 }
 
 func (r *ImportListStevenlu2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var importList *ImportListStevenlu2
 
@@ -223,8 +231,12 @@ func (r *ImportListStevenlu2Resource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get ImportListStevenlu2 current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, importListStevenlu2ResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListStevenlu2ResourceName, err))
 
 		return
@@ -237,6 +249,9 @@ func (r *ImportListStevenlu2Resource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *ImportListStevenlu2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var importList *ImportListStevenlu2
 
@@ -249,9 +264,9 @@ func (r *ImportListStevenlu2Resource) Update(ctx context.Context, req resource.U
 	// Update ImportListStevenlu2
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListStevenlu2ResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, importListStevenlu2ResourceName, err)
 
 		return
 	}
@@ -263,6 +278,9 @@ func (r *ImportListStevenlu2Resource) Update(ctx context.Context, req resource.U
 }
 
 func (r *ImportListStevenlu2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -272,7 +290,7 @@ func (r *ImportListStevenlu2Resource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Delete ImportListStevenlu2 current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListStevenlu2ResourceName, err))
 
@@ -284,7 +302,7 @@ func (r *ImportListStevenlu2Resource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *ImportListStevenlu2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), importListImportLookups(r.auth.apiContext(ctx), r.client, importListStevenlu2Implementation), req, resp)
 	tflog.Trace(ctx, "imported "+importListStevenlu2ResourceName+": "+req.ID)
 }
 
@@ -38,12 +38,13 @@ func NewImportListTMDBCompanyResource() resource.Resource {
 // ImportListTMDBCompanyResource defines the import list implementation.
 type ImportListTMDBCompanyResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // ImportListTMDBCompany describes the import list data model.
 type ImportListTMDBCompany struct {
 	Tags                types.Set    `tfsdk:"tags"`
+	Timeouts            types.Object `tfsdk:"timeouts"`
 	Name                types.String `tfsdk:"name"`
 	Monitor             types.String `tfsdk:"monitor"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
@@ -100,6 +101,7 @@ func (r *ImportListTMDBCompanyResource) Schema(_ context.Context, _ resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImport List TMDB Company resource.\nFor more information refer to [Import List](https://wiki.servarr.com/radarr/settings#import-lists) and [TMDB Company](https://wiki.servarr.com/radarr/supported#tmdbcompanyimport).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -176,6 +178,9 @@ func (r *ImportListTMDBCompanyResource) Configure(ctx context.Context, req resou
 }
 
 func (r *ImportListTMDBCompanyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var importList *ImportListTMDBCompany
 
@@ -188,9 +193,9 @@ func (r *ImportListTMDBCompanyResource) Create(ctx context.Context, req resource
 	// Create new ImportListTMDBCompany
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTMDBCompanyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, importListTMDBCompanyResourceName, err)
 
 		return
 	}
@@ -202,6 +207,9 @@ func (r *ImportListTMDBCompanyResource) Create(ctx context.Context, req resource
 }
 
 func (r *ImportListTMDBCompanyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var importList *ImportListTMDBCompany
 
@@ -212,8 +220,12 @@ func (r *ImportListTMDBCompanyResource) Read(ctx context.Context, req resource.R
 	}
 
 	// Get ImportListTMDBCompany current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, importListTMDBCompanyResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTMDBCompanyResourceName, err))
 
 		return
@@ -226,6 +238,9 @@ func (r *ImportListTMDBCompanyResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *ImportListTMDBCompanyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var importList *ImportListTMDBCompany
 
@@ -238,9 +253,9 @@ func (r *ImportListTMDBCompanyResource) Update(ctx context.Context, req resource
 	// Update ImportListTMDBCompany
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTMDBCompanyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, importListTMDBCompanyResourceName, err)
 
 		return
 	}
@@ -252,6 +267,9 @@ func (r *ImportListTMDBCompanyResource) Update(ctx context.Context, req resource
 }
 
 func (r *ImportListTMDBCompanyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -261,7 +279,7 @@ func (r *ImportListTMDBCompanyResource) Delete(ctx context.Context, req resource
 	}
 
 	// Delete ImportListTMDBCompany current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListTMDBCompanyResourceName, err))
 
@@ -273,7 +291,7 @@ func (r *ImportListTMDBCompanyResource) Delete(ctx context.Context, req resource
 }
 
 func (r *ImportListTMDBCompanyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), importListImportLookups(r.auth.apiContext(ctx), r.client, importListTMDBCompanyImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+importListTMDBCompanyResourceName+": "+req.ID)
 }
 
@@ -38,12 +38,13 @@ func NewImportListTMDBPersonResource() resource.Resource {
 // ImportListTMDBPersonResource defines the import list implementation.
 type ImportListTMDBPersonResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // ImportListTMDBPerson describes the import list data model.
 type ImportListTMDBPerson struct {
 	Tags                types.Set    `tfsdk:"tags"`
+	Timeouts            types.Object `tfsdk:"timeouts"`
 	Name                types.String `tfsdk:"name"`
 	Monitor             types.String `tfsdk:"monitor"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
@@ -115,6 +116,7 @@ func (r *ImportListTMDBPersonResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImport List TMDB Person resource.\nFor more information refer to [Import List](https://wiki.servarr.com/radarr/settings#import-lists) and [TMDB Person](https://wiki.servarr.com/radarr/supported#tmdbpersonimport).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -216,6 +218,9 @@ func (r *ImportListTMDBPersonResource) Configure(ctx context.Context, req resour
 }
 
 func (r *ImportListTMDBPersonResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var importList *ImportListTMDBPerson
 
@@ -228,9 +233,9 @@ func (r *ImportListTMDBPersonResource) Create(ctx context.Context, req resource.
 	// Create new ImportListTMDBPerson
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTMDBPersonResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, importListTMDBPersonResourceName, err)
 
 		return
 	}
@@ -242,6 +247,9 @@ func (r *ImportListTMDBPersonResource) Create(ctx context.Context, req resource.
 }
 
 func (r *ImportListTMDBPersonResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var importList *ImportListTMDBPerson
 
@@ -252,8 +260,12 @@ func (r *ImportListTMDBPersonResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get ImportListTMDBPerson current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, importListTMDBPersonResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTMDBPersonResourceName, err))
 
 		return
@@ -266,6 +278,9 @@ func (r *ImportListTMDBPersonResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *ImportListTMDBPersonResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var importList *ImportListTMDBPerson
 
@@ -278,9 +293,9 @@ func (r *ImportListTMDBPersonResource) Update(ctx context.Context, req resource.
 	// Update ImportListTMDBPerson
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTMDBPersonResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, importListTMDBPersonResourceName, err)
 
 		return
 	}
@@ -292,6 +307,9 @@ func (r *ImportListTMDBPersonResource) Update(ctx context.Context, req resource.
 }
 
 func (r *ImportListTMDBPersonResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -301,7 +319,7 @@ func (r *ImportListTMDBPersonResource) Delete(ctx context.Context, req resource.
 	}
 
 	// Delete ImportListTMDBPerson current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListTMDBPersonResourceName, err))
 
@@ -313,7 +331,7 @@ func (r *ImportListTMDBPersonResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *ImportListTMDBPersonResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), importListImportLookups(r.auth.apiContext(ctx), r.client, importListTMDBPersonImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+importListTMDBPersonResourceName+": "+req.ID)
 }
 
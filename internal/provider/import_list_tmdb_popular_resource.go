@@ -39,12 +39,13 @@ func NewImportListTMDBPopularResource() resource.Resource {
 // ImportListTMDBPopularResource defines the import list implementation.
 type ImportListTMDBPopularResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // ImportListTMDBPopular describes the import list data model.
 type ImportListTMDBPopular struct {
 	Tags                types.Set    `tfsdk:"tags"`
+	Timeouts            types.Object `tfsdk:"timeouts"`
 	Name                types.String `tfsdk:"name"`
 	Monitor             types.String `tfsdk:"monitor"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
@@ -119,6 +120,7 @@ func (r *ImportListTMDBPopularResource) Schema(_ context.Context, _ resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImport List TMDB Popular resource.\nFor more information refer to [Import List](https://wiki.servarr.com/radarr/settings#import-lists) and [TMDB Popular](https://wiki.servarr.com/radarr/supported#tmdbpopularimport).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -227,6 +229,9 @@ func (r *ImportListTMDBPopularResource) Configure(ctx context.Context, req resou
 }
 
 func (r *ImportListTMDBPopularResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var importList *ImportListTMDBPopular
 
@@ -239,9 +244,9 @@ func (r *ImportListTMDBPopularResource) Create(ctx context.Context, req resource
 	// Create new ImportListTMDBPopular
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTMDBPopularResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, importListTMDBPopularResourceName, err)
 
 		return
 	}
@@ -253,6 +258,9 @@ func (r *ImportListTMDBPopularResource) Create(ctx context.Context, req resource
 }
 
 func (r *ImportListTMDBPopularResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var importList *ImportListTMDBPopular
 
@@ -263,8 +271,12 @@ func (r *ImportListTMDBPopularResource) Read(ctx context.Context, req resource.R
 	}
 
 	// Get ImportListTMDBPopular current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, importListTMDBPopularResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTMDBPopularResourceName, err))
 
 		return
@@ -277,6 +289,9 @@ func (r *ImportListTMDBPopularResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *ImportListTMDBPopularResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var importList *ImportListTMDBPopular
 
@@ -289,9 +304,9 @@ func (r *ImportListTMDBPopularResource) Update(ctx context.Context, req resource
 	// Update ImportListTMDBPopular
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTMDBPopularResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, importListTMDBPopularResourceName, err)
 
 		return
 	}
@@ -303,6 +318,9 @@ func (r *ImportListTMDBPopularResource) Update(ctx context.Context, req resource
 }
 
 func (r *ImportListTMDBPopularResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -312,7 +330,7 @@ func (r *ImportListTMDBPopularResource) Delete(ctx context.Context, req resource
 	}
 
 	// Delete ImportListTMDBPopular current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListTMDBPopularResourceName, err))
 
@@ -324,7 +342,7 @@ func (r *ImportListTMDBPopularResource) Delete(ctx context.Context, req resource
 }
 
 func (r *ImportListTMDBPopularResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), importListImportLookups(r.auth.apiContext(ctx), r.client, importListTMDBPopularImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+importListTMDBPopularResourceName+": "+req.ID)
 }
 
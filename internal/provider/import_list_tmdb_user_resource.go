@@ -39,12 +39,13 @@ func NewImportListTMDBUserResource() resource.Resource {
 // ImportListTMDBUserResource defines the import list implementation.
 type ImportListTMDBUserResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // ImportListTMDBUser describes the import list data model.
 type ImportListTMDBUser struct {
 	Tags                types.Set    `tfsdk:"tags"`
+	Timeouts            types.Object `tfsdk:"timeouts"`
 	Name                types.String `tfsdk:"name"`
 	Monitor             types.String `tfsdk:"monitor"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
@@ -107,6 +108,7 @@ func (r *ImportListTMDBUserResource) Schema(_ context.Context, _ resource.Schema
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImport List TMDB User resource.\nFor more information refer to [Import List](https://wiki.servarr.com/radarr/settings#import-lists) and [TMDB User](https://wiki.servarr.com/radarr/supported#tmdbuserimport).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -195,6 +197,9 @@ func (r *ImportListTMDBUserResource) Configure(ctx context.Context, req resource
 }
 
 func (r *ImportListTMDBUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var importList *ImportListTMDBUser
 
@@ -207,9 +212,9 @@ func (r *ImportListTMDBUserResource) Create(ctx context.Context, req resource.Cr
 	// Create new ImportListTMDBUser
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTMDBUserResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, importListTMDBUserResourceName, err)
 
 		return
 	}
@@ -221,6 +226,9 @@ func (r *ImportListTMDBUserResource) Create(ctx context.Context, req resource.Cr
 }
 
 func (r *ImportListTMDBUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var importList *ImportListTMDBUser
 
@@ -231,8 +239,12 @@ func (r *ImportListTMDBUserResource) Read(ctx context.Context, req resource.Read
 	}
 
 	// Get ImportListTMDBUser current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, importListTMDBUserResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTMDBUserResourceName, err))
 
 		return
@@ -245,6 +257,9 @@ func (r *ImportListTMDBUserResource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *ImportListTMDBUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var importList *ImportListTMDBUser
 
@@ -257,9 +272,9 @@ func (r *ImportListTMDBUserResource) Update(ctx context.Context, req resource.Up
 	// Update ImportListTMDBUser
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTMDBUserResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, importListTMDBUserResourceName, err)
 
 		return
 	}
@@ -271,6 +286,9 @@ func (r *ImportListTMDBUserResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *ImportListTMDBUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -280,7 +298,7 @@ func (r *ImportListTMDBUserResource) Delete(ctx context.Context, req resource.De
 	}
 
 	// Delete ImportListTMDBUser current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListTMDBUserResourceName, err))
 
@@ -292,7 +310,7 @@ func (r *ImportListTMDBUserResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *ImportListTMDBUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), importListImportLookups(r.auth.apiContext(ctx), r.client, importListTMDBUserImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+importListTMDBUserResourceName+": "+req.ID)
 }
 
@@ -39,12 +39,13 @@ func NewImportListTraktPopularResource() resource.Resource {
 // ImportListTraktPopularResource defines the import list implementation.
 type ImportListTraktPopularResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // ImportListTraktPopular describes the import list data model.
 type ImportListTraktPopular struct {
 	Tags                      types.Set    `tfsdk:"tags"`
+	Timeouts                  types.Object `tfsdk:"timeouts"`
 	Name                      types.String `tfsdk:"name"`
 	Monitor                   types.String `tfsdk:"monitor"`
 	MinimumAvailability       types.String `tfsdk:"minimum_availability"`
@@ -131,6 +132,7 @@ func (r *ImportListTraktPopularResource) Schema(_ context.Context, _ resource.Sc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImport List Trakt Popular resource.\nFor more information refer to [Import List](https://wiki.servarr.com/radarr/settings#import-lists) and [Trakt Popular](https://wiki.servarr.com/radarr/supported#traktpopularimport).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -260,6 +262,9 @@ func (r *ImportListTraktPopularResource) Configure(ctx context.Context, req reso
 }
 
 func (r *ImportListTraktPopularResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var importList *ImportListTraktPopular
 
@@ -272,9 +277,9 @@ func (r *ImportListTraktPopularResource) Create(ctx context.Context, req resourc
 	// Create new ImportListTraktPopular
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTraktPopularResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, importListTraktPopularResourceName, err)
 
 		return
 	}
@@ -286,6 +291,9 @@ func (r *ImportListTraktPopularResource) Create(ctx context.Context, req resourc
 }
 
 func (r *ImportListTraktPopularResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var importList *ImportListTraktPopular
 
@@ -296,8 +304,12 @@ func (r *ImportListTraktPopularResource) Read(ctx context.Context, req resource.
 	}
 
 	// Get ImportListTraktPopular current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, importListTraktPopularResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTraktPopularResourceName, err))
 
 		return
@@ -310,6 +322,9 @@ func (r *ImportListTraktPopularResource) Read(ctx context.Context, req resource.
 }
 
 func (r *ImportListTraktPopularResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var importList *ImportListTraktPopular
 
@@ -322,9 +337,9 @@ func (r *ImportListTraktPopularResource) Update(ctx context.Context, req resourc
 	// Update ImportListTraktPopular
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTraktPopularResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, importListTraktPopularResourceName, err)
 
 		return
 	}
@@ -336,6 +351,9 @@ func (r *ImportListTraktPopularResource) Update(ctx context.Context, req resourc
 }
 
 func (r *ImportListTraktPopularResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -345,7 +363,7 @@ func (r *ImportListTraktPopularResource) Delete(ctx context.Context, req resourc
 	}
 
 	// Delete ImportListTraktPopular current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListTraktPopularResourceName, err))
 
@@ -357,7 +375,7 @@ func (r *ImportListTraktPopularResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *ImportListTraktPopularResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), importListImportLookups(r.auth.apiContext(ctx), r.client, importListTraktPopularImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+importListTraktPopularResourceName+": "+req.ID)
 }
 
@@ -24,7 +24,7 @@ func NewImportListsDataSource() datasource.DataSource {
 // ImportListsDataSource defines the import lists implementation.
 type ImportListsDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // ImportLists describes the import lists data model.
@@ -297,8 +297,10 @@ func (d *ImportListsDataSource) Configure(ctx context.Context, req datasource.Co
 }
 
 func (d *ImportListsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get import lists current value
-	response, _, err := d.client.ImportListAPI.ListImportList(d.auth).Execute()
+	response, _, err := d.client.ImportListAPI.ListImportList(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListsDataSourceName, err))
 
@@ -22,7 +22,7 @@ func NewIndexerConfigDataSource() datasource.DataSource {
 // IndexerConfigDataSource defines the indexer config implementation.
 type IndexerConfigDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *IndexerConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -82,8 +82,10 @@ func (d *IndexerConfigDataSource) Configure(ctx context.Context, req datasource.
 }
 
 func (d *IndexerConfigDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get indexer config current value
-	response, _, err := d.client.IndexerConfigAPI.GetIndexerConfig(d.auth).Execute()
+	response, _, err := d.client.IndexerConfigAPI.GetIndexerConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerConfigDataSourceName, err))
 
@@ -30,7 +30,7 @@ func NewIndexerConfigResource() resource.Resource {
 // IndexerConfigResource defines the indexer config implementation.
 type IndexerConfigResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // IndexerConfig describes the indexer config data model.
@@ -46,6 +46,12 @@ type IndexerConfig struct {
 	AllowHardcodedSubs       types.Bool   `tfsdk:"allow_hardcoded_subs"`
 }
 
+// IndexerConfigResourceModel describes the indexer config resource data model.
+type IndexerConfigResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	IndexerConfig
+}
+
 func (r *IndexerConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + indexerConfigResourceName
 }
@@ -54,6 +60,7 @@ func (r *IndexerConfigResource) Schema(_ context.Context, _ resource.SchemaReque
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Config resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/radarr/settings#options) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Indexer Config ID.",
 				Computed:            true,
@@ -105,8 +112,11 @@ func (r *IndexerConfigResource) Configure(ctx context.Context, req resource.Conf
 }
 
 func (r *IndexerConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var config *IndexerConfig
+	var config *IndexerConfigResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
 
@@ -119,9 +129,9 @@ func (r *IndexerConfigResource) Create(ctx context.Context, req resource.CreateR
 	request.SetId(1)
 
 	// Create new IndexerConfig
-	response, _, err := r.client.IndexerConfigAPI.UpdateIndexerConfig(r.auth, strconv.Itoa(int(request.GetId()))).IndexerConfigResource(*request).Execute()
+	response, _, err := r.client.IndexerConfigAPI.UpdateIndexerConfig(ctx, strconv.Itoa(int(request.GetId()))).IndexerConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerConfigResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, indexerConfigResourceName, err)
 
 		return
 	}
@@ -133,8 +143,11 @@ func (r *IndexerConfigResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *IndexerConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var config *IndexerConfig
+	var config *IndexerConfigResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
 
@@ -143,7 +156,7 @@ func (r *IndexerConfigResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Get indexerConfig current value
-	response, _, err := r.client.IndexerConfigAPI.GetIndexerConfig(r.auth).Execute()
+	response, _, err := r.client.IndexerConfigAPI.GetIndexerConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerConfigResourceName, err))
 
@@ -157,8 +170,11 @@ func (r *IndexerConfigResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *IndexerConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var config *IndexerConfig
+	var config *IndexerConfigResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
 
@@ -170,9 +186,9 @@ func (r *IndexerConfigResource) Update(ctx context.Context, req resource.UpdateR
 	request := config.read()
 
 	// Update IndexerConfig
-	response, _, err := r.client.IndexerConfigAPI.UpdateIndexerConfig(r.auth, strconv.Itoa(int(request.GetId()))).IndexerConfigResource(*request).Execute()
+	response, _, err := r.client.IndexerConfigAPI.UpdateIndexerConfig(ctx, strconv.Itoa(int(request.GetId()))).IndexerConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerConfigResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, indexerConfigResourceName, err)
 
 		return
 	}
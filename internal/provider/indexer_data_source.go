@@ -24,7 +24,7 @@ func NewIndexerDataSource() datasource.DataSource {
 // IndexerDataSource defines the indexer implementation.
 type IndexerDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *IndexerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -187,6 +187,8 @@ func (d *IndexerDataSource) Configure(ctx context.Context, req datasource.Config
 }
 
 func (d *IndexerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	var data *Indexer
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -195,7 +197,7 @@ func (d *IndexerDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 	// Get indexer current value
-	response, _, err := d.client.IndexerAPI.ListIndexer(d.auth).Execute()
+	response, _, err := d.client.IndexerAPI.ListIndexer(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerDataSourceName, err))
 
@@ -36,11 +36,12 @@ func NewIndexerFilelistResource() resource.Resource {
 // IndexerFilelistResource defines the Filelist indexer implementation.
 type IndexerFilelistResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // IndexerFilelist describes the Filelist indexer data model.
 type IndexerFilelist struct {
+	Timeouts                types.Object  `tfsdk:"timeouts"`
 	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
 	Categories              types.Set     `tfsdk:"categories"`
 	MultiLanguages          types.Set     `tfsdk:"multi_languages"`
@@ -113,6 +114,7 @@ func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer FileList resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/radarr/settings#indexers) and [FileList](https://wiki.servarr.com/radarr/supported#filelist).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -215,6 +217,9 @@ func (r *IndexerFilelistResource) Configure(ctx context.Context, req resource.Co
 }
 
 func (r *IndexerFilelistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var indexer *IndexerFilelist
 
@@ -227,9 +232,9 @@ func (r *IndexerFilelistResource) Create(ctx context.Context, req resource.Creat
 	// Create new IndexerFilelist
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerFilelistResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, indexerFilelistResourceName, err)
 
 		return
 	}
@@ -241,6 +246,9 @@ func (r *IndexerFilelistResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *IndexerFilelistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var indexer *IndexerFilelist
 
@@ -251,8 +259,12 @@ func (r *IndexerFilelistResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get IndexerFilelist current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, indexerFilelistResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerFilelistResourceName, err))
 
 		return
@@ -265,6 +277,9 @@ func (r *IndexerFilelistResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *IndexerFilelistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var indexer *IndexerFilelist
 
@@ -277,9 +292,9 @@ func (r *IndexerFilelistResource) Update(ctx context.Context, req resource.Updat
 	// Update IndexerFilelist
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(ctx, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerFilelistResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, indexerFilelistResourceName, err)
 
 		return
 	}
@@ -291,6 +306,9 @@ func (r *IndexerFilelistResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *IndexerFilelistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -300,7 +318,7 @@ func (r *IndexerFilelistResource) Delete(ctx context.Context, req resource.Delet
 	}
 
 	// Delete IndexerFilelist current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerFilelistResourceName, err))
 
@@ -312,7 +330,7 @@ func (r *IndexerFilelistResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *IndexerFilelistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), indexerImportLookups(r.auth.apiContext(ctx), r.client, indexerFilelistImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+indexerFilelistResourceName+": "+req.ID)
 }
 
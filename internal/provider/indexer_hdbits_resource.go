@@ -36,11 +36,12 @@ func NewIndexerHdbitsResource() resource.Resource {
 // IndexerHdbitsResource defines the Hdbits indexer implementation.
 type IndexerHdbitsResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // IndexerHdbits describes the Hdbits indexer data model.
 type IndexerHdbits struct {
+	Timeouts                types.Object  `tfsdk:"timeouts"`
 	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
 	Tags                    types.Set     `tfsdk:"tags"`
 	Categories              types.Set     `tfsdk:"categories"`
@@ -119,6 +120,7 @@ func (r *IndexerHdbitsResource) Schema(_ context.Context, _ resource.SchemaReque
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer HDBits resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/radarr/settings#indexers) and [HDBits](https://wiki.servarr.com/radarr/supported#hdbits).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -233,6 +235,9 @@ func (r *IndexerHdbitsResource) Configure(ctx context.Context, req resource.Conf
 }
 
 func (r *IndexerHdbitsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var indexer *IndexerHdbits
 
@@ -245,9 +250,9 @@ func (r *IndexerHdbitsResource) Create(ctx context.Context, req resource.CreateR
 	// Create new IndexerHdbits
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerHdbitsResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, indexerHdbitsResourceName, err)
 
 		return
 	}
@@ -259,6 +264,9 @@ func (r *IndexerHdbitsResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *IndexerHdbitsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var indexer *IndexerHdbits
 
@@ -269,8 +277,12 @@ func (r *IndexerHdbitsResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Get IndexerHdbits current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, indexerHdbitsResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerHdbitsResourceName, err))
 
 		return
@@ -283,6 +295,9 @@ func (r *IndexerHdbitsResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *IndexerHdbitsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var indexer *IndexerHdbits
 
@@ -295,9 +310,9 @@ func (r *IndexerHdbitsResource) Update(ctx context.Context, req resource.UpdateR
 	// Update IndexerHdbits
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(ctx, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerHdbitsResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, indexerHdbitsResourceName, err)
 
 		return
 	}
@@ -309,6 +324,9 @@ func (r *IndexerHdbitsResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *IndexerHdbitsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -318,7 +336,7 @@ func (r *IndexerHdbitsResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	// Delete IndexerHdbits current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerHdbitsResourceName, err))
 
@@ -330,7 +348,7 @@ func (r *IndexerHdbitsResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *IndexerHdbitsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), indexerImportLookups(r.auth.apiContext(ctx), r.client, indexerHdbitsImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+indexerHdbitsResourceName+": "+req.ID)
 }
 
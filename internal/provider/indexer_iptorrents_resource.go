@@ -36,11 +36,12 @@ func NewIndexerIptorrentsResource() resource.Resource {
 // IndexerIptorrentsResource defines the Iptorrents indexer implementation.
 type IndexerIptorrentsResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // IndexerIptorrents describes the Iptorrents indexer data model.
 type IndexerIptorrents struct {
+	Timeouts         types.Object  `tfsdk:"timeouts"`
 	SeedRatio        types.Float64 `tfsdk:"seed_ratio"`
 	Tags             types.Set     `tfsdk:"tags"`
 	MultiLanguages   types.Set     `tfsdk:"multi_languages"`
@@ -98,6 +99,7 @@ func (r *IndexerIptorrentsResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer IP Torrents resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/radarr/settings#indexers) and [IP Torrents](https://wiki.servarr.com/radarr/supported#iptorrents).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_rss": schema.BoolAttribute{
 				MarkdownDescription: "Enable RSS flag.",
 				Optional:            true,
@@ -174,6 +176,9 @@ func (r *IndexerIptorrentsResource) Configure(ctx context.Context, req resource.
 }
 
 func (r *IndexerIptorrentsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var indexer *IndexerIptorrents
 
@@ -186,9 +191,9 @@ func (r *IndexerIptorrentsResource) Create(ctx context.Context, req resource.Cre
 	// Create new IndexerIptorrents
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerIptorrentsResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, indexerIptorrentsResourceName, err)
 
 		return
 	}
@@ -200,6 +205,9 @@ func (r *IndexerIptorrentsResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *IndexerIptorrentsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var indexer *IndexerIptorrents
 
@@ -210,8 +218,12 @@ func (r *IndexerIptorrentsResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get IndexerIptorrents current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, indexerIptorrentsResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerIptorrentsResourceName, err))
 
 		return
@@ -224,6 +236,9 @@ func (r *IndexerIptorrentsResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *IndexerIptorrentsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var indexer *IndexerIptorrents
 
@@ -236,9 +251,9 @@ func (r *IndexerIptorrentsResource) Update(ctx context.Context, req resource.Upd
 	// Update IndexerIptorrents
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(ctx, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerIptorrentsResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, indexerIptorrentsResourceName, err)
 
 		return
 	}
@@ -250,6 +265,9 @@ func (r *IndexerIptorrentsResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *IndexerIptorrentsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -259,7 +277,7 @@ func (r *IndexerIptorrentsResource) Delete(ctx context.Context, req resource.Del
 	}
 
 	// Delete IndexerIptorrents current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerIptorrentsResourceName, err))
 
@@ -271,7 +289,7 @@ func (r *IndexerIptorrentsResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *IndexerIptorrentsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), indexerImportLookups(r.auth.apiContext(ctx), r.client, indexerIptorrentsImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+indexerIptorrentsResourceName+": "+req.ID)
 }
 
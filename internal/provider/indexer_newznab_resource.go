@@ -36,7 +36,7 @@ func NewIndexerNewznabResource() resource.Resource {
 // IndexerNewznabResource defines the Newznab indexer implementation.
 type IndexerNewznabResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // IndexerNewznab describes the Newznab indexer data model.
@@ -44,6 +44,7 @@ type IndexerNewznab struct {
 	Tags                    types.Set    `tfsdk:"tags"`
 	Categories              types.Set    `tfsdk:"categories"`
 	MultiLanguages          types.Set    `tfsdk:"multi_languages"`
+	Timeouts                types.Object `tfsdk:"timeouts"`
 	AdditionalParameters    types.String `tfsdk:"additional_parameters"`
 	BaseURL                 types.String `tfsdk:"base_url"`
 	APIPath                 types.String `tfsdk:"api_path"`
@@ -107,6 +108,7 @@ func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Newznab resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/radarr/settings#indexers) and [Newznab](https://wiki.servarr.com/radarr/supported#newznab).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -200,6 +202,9 @@ func (r *IndexerNewznabResource) Configure(ctx context.Context, req resource.Con
 }
 
 func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var indexer *IndexerNewznab
 
@@ -212,9 +217,9 @@ func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.Create
 	// Create new IndexerNewznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerNewznabResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, indexerNewznabResourceName, err)
 
 		return
 	}
@@ -226,6 +231,9 @@ func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *IndexerNewznabResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var indexer *IndexerNewznab
 
@@ -236,8 +244,12 @@ func (r *IndexerNewznabResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get IndexerNewznab current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, indexerNewznabResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerNewznabResourceName, err))
 
 		return
@@ -250,6 +262,9 @@ func (r *IndexerNewznabResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *IndexerNewznabResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var indexer *IndexerNewznab
 
@@ -262,9 +277,9 @@ func (r *IndexerNewznabResource) Update(ctx context.Context, req resource.Update
 	// Update IndexerNewznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(ctx, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerNewznabResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, indexerNewznabResourceName, err)
 
 		return
 	}
@@ -276,6 +291,9 @@ func (r *IndexerNewznabResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *IndexerNewznabResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -285,7 +303,7 @@ func (r *IndexerNewznabResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	// Delete IndexerNewznab current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerNewznabResourceName, err))
 
@@ -297,7 +315,7 @@ func (r *IndexerNewznabResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *IndexerNewznabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), indexerImportLookups(r.auth.apiContext(ctx), r.client, indexerNewznabImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+indexerNewznabResourceName+": "+req.ID)
 }
 
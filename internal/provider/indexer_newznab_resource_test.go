@@ -47,6 +47,13 @@ func TestAccIndexerNewznabResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "radarr_indexer_newznab.test",
+				ImportStateId:     "name=newzabResourceTest",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -36,11 +36,12 @@ func NewIndexerNyaaResource() resource.Resource {
 // IndexerNyaaResource defines the Nyaa indexer implementation.
 type IndexerNyaaResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // IndexerNyaa describes the Nyaa indexer data model.
 type IndexerNyaa struct {
+	Timeouts                types.Object  `tfsdk:"timeouts"`
 	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
 	MultiLanguages          types.Set     `tfsdk:"multi_languages"`
 	RequiredFlags           types.Set     `tfsdk:"required_flags"`
@@ -107,6 +108,7 @@ func (r *IndexerNyaaResource) Schema(_ context.Context, _ resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Nyaa resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/radarr/settings#indexers) and [Nyaa](https://wiki.servarr.com/radarr/supported#nyaa).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -198,6 +200,9 @@ func (r *IndexerNyaaResource) Configure(ctx context.Context, req resource.Config
 }
 
 func (r *IndexerNyaaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var indexer *IndexerNyaa
 
@@ -210,9 +215,9 @@ func (r *IndexerNyaaResource) Create(ctx context.Context, req resource.CreateReq
 	// Create new IndexerNyaa
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerNyaaResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, indexerNyaaResourceName, err)
 
 		return
 	}
@@ -224,6 +229,9 @@ func (r *IndexerNyaaResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *IndexerNyaaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var indexer *IndexerNyaa
 
@@ -234,8 +242,12 @@ func (r *IndexerNyaaResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Get IndexerNyaa current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, indexerNyaaResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerNyaaResourceName, err))
 
 		return
@@ -248,6 +260,9 @@ func (r *IndexerNyaaResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *IndexerNyaaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var indexer *IndexerNyaa
 
@@ -260,9 +275,9 @@ func (r *IndexerNyaaResource) Update(ctx context.Context, req resource.UpdateReq
 	// Update IndexerNyaa
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(ctx, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerNyaaResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, indexerNyaaResourceName, err)
 
 		return
 	}
@@ -274,6 +289,9 @@ func (r *IndexerNyaaResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *IndexerNyaaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -283,7 +301,7 @@ func (r *IndexerNyaaResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	// Delete IndexerNyaa current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerNyaaResourceName, err))
 
@@ -295,7 +313,7 @@ func (r *IndexerNyaaResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *IndexerNyaaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), indexerImportLookups(r.auth.apiContext(ctx), r.client, indexerNyaaImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+indexerNyaaResourceName+": "+req.ID)
 }
 
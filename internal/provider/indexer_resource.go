@@ -44,7 +44,7 @@ func NewIndexerResource() resource.Resource {
 // IndexerResource defines the indexer implementation.
 type IndexerResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // Indexer describes the indexer data model.
@@ -84,6 +84,12 @@ type Indexer struct {
 	RemoveYear              types.Bool    `tfsdk:"remove_year"`
 }
 
+// IndexerResourceModel describes the indexer resource data model.
+type IndexerResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	Indexer
+}
+
 func (i Indexer) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -131,6 +137,7 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nGeneric Indexer resource. When possible use a specific resource instead.\nFor more information refer to [Indexer](https://wiki.servarr.com/radarr/settings#indexers) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -318,8 +325,11 @@ func (r *IndexerResource) Configure(ctx context.Context, req resource.ConfigureR
 }
 
 func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var indexer *Indexer
+	var indexer *IndexerResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
 
@@ -330,9 +340,9 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 	// Create new Indexer
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, indexerResourceName, err)
 
 		return
 	}
@@ -340,16 +350,20 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 	tflog.Trace(ctx, "created "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Indexer
+	var state IndexerResourceModel
 
-	state.writeSensitive(indexer)
+	state.Timeouts = indexer.Timeouts
+	state.writeSensitive(&indexer.Indexer)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var indexer *Indexer
+	var indexer *IndexerResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &indexer)...)
 
@@ -358,8 +372,12 @@ func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Get Indexer current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, indexerResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerResourceName, err))
 
 		return
@@ -368,16 +386,20 @@ func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, re
 	tflog.Trace(ctx, "read "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Indexer
+	var state IndexerResourceModel
 
-	state.writeSensitive(indexer)
+	state.Timeouts = indexer.Timeouts
+	state.writeSensitive(&indexer.Indexer)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var indexer *Indexer
+	var indexer *IndexerResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
 
@@ -388,9 +410,9 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Update Indexer
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(ctx, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, indexerResourceName, err)
 
 		return
 	}
@@ -398,14 +420,18 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 	tflog.Trace(ctx, "updated "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Indexer
+	var state IndexerResourceModel
 
-	state.writeSensitive(indexer)
+	state.Timeouts = indexer.Timeouts
+	state.writeSensitive(&indexer.Indexer)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *IndexerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -415,7 +441,7 @@ func (r *IndexerResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	// Delete Indexer current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerResourceName, err))
 
@@ -427,10 +453,24 @@ func (r *IndexerResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *IndexerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), indexerImportLookups(r.auth.apiContext(ctx), r.client, ""), req, resp)
 	tflog.Trace(ctx, "imported "+indexerResourceName+": "+req.ID)
 }
 
+// indexerImportLookups resolves the indexer import identifiers, only matching the given implementation if set.
+func indexerImportLookups(ctx context.Context, client *radarr.APIClient, implementation string) map[string]helpers.ImportLookup {
+	return map[string]helpers.ImportLookup{
+		"name=": func(name string) (int, error) {
+			indexers, _, err := client.IndexerAPI.ListIndexer(ctx).Execute()
+			if err != nil {
+				return 0, err
+			}
+
+			return helpers.FindImportID(helpers.FilterImplementation(indexers, implementation), name, (*radarr.IndexerResource).GetName)
+		},
+	}
+}
+
 func (i *Indexer) write(ctx context.Context, indexer *radarr.IndexerResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
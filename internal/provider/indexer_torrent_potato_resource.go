@@ -36,11 +36,12 @@ func NewIndexerTorrentPotatoResource() resource.Resource {
 // IndexerTorrentPotatoResource defines the TorrentPotato indexer implementation.
 type IndexerTorrentPotatoResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // IndexerTorrentPotato describes the TorrentPotato indexer data model.
 type IndexerTorrentPotato struct {
+	Timeouts                types.Object  `tfsdk:"timeouts"`
 	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
 	Tags                    types.Set     `tfsdk:"tags"`
 	MultiLanguages          types.Set     `tfsdk:"multi_languages"`
@@ -110,6 +111,7 @@ func (r *IndexerTorrentPotatoResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer TorrentPotato resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/radarr/settings#indexers) and [TorrentPotato](https://wiki.servarr.com/radarr/supported#torrentpotato).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -207,6 +209,9 @@ func (r *IndexerTorrentPotatoResource) Configure(ctx context.Context, req resour
 }
 
 func (r *IndexerTorrentPotatoResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var indexer *IndexerTorrentPotato
 
@@ -219,9 +224,9 @@ func (r *IndexerTorrentPotatoResource) Create(ctx context.Context, req resource.
 	// Create new IndexerTorrentPotato
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerTorrentPotatoResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, indexerTorrentPotatoResourceName, err)
 
 		return
 	}
@@ -233,6 +238,9 @@ func (r *IndexerTorrentPotatoResource) Create(ctx context.Context, req resource.
 }
 
 func (r *IndexerTorrentPotatoResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var indexer *IndexerTorrentPotato
 
@@ -243,8 +251,12 @@ func (r *IndexerTorrentPotatoResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get IndexerTorrentPotato current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, indexerTorrentPotatoResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerTorrentPotatoResourceName, err))
 
 		return
@@ -257,6 +269,9 @@ func (r *IndexerTorrentPotatoResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *IndexerTorrentPotatoResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var indexer *IndexerTorrentPotato
 
@@ -269,9 +284,9 @@ func (r *IndexerTorrentPotatoResource) Update(ctx context.Context, req resource.
 	// Update IndexerTorrentPotato
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(ctx, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerTorrentPotatoResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, indexerTorrentPotatoResourceName, err)
 
 		return
 	}
@@ -283,6 +298,9 @@ func (r *IndexerTorrentPotatoResource) Update(ctx context.Context, req resource.
 }
 
 func (r *IndexerTorrentPotatoResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -292,7 +310,7 @@ func (r *IndexerTorrentPotatoResource) Delete(ctx context.Context, req resource.
 	}
 
 	// Delete IndexerTorrentPotato current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerTorrentPotatoResourceName, err))
 
@@ -304,7 +322,7 @@ func (r *IndexerTorrentPotatoResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *IndexerTorrentPotatoResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), indexerImportLookups(r.auth.apiContext(ctx), r.client, indexerTorrentPotatoImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+indexerTorrentPotatoResourceName+": "+req.ID)
 }
 
@@ -36,11 +36,12 @@ func NewIndexerTorrentRssResource() resource.Resource {
 // IndexerTorrentRssResource defines the TorrentRss indexer implementation.
 type IndexerTorrentRssResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // IndexerTorrentRss describes the TorrentRss indexer data model.
 type IndexerTorrentRss struct {
+	Timeouts         types.Object  `tfsdk:"timeouts"`
 	SeedRatio        types.Float64 `tfsdk:"seed_ratio"`
 	MultiLanguages   types.Set     `tfsdk:"multi_languages"`
 	RequiredFlags    types.Set     `tfsdk:"required_flags"`
@@ -104,6 +105,7 @@ func (r *IndexerTorrentRssResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Torrent RSS resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/radarr/settings#indexers) and [Torrent RSS](https://wiki.servarr.com/radarr/supported#torrentrssindexer).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable_rss": schema.BoolAttribute{
 				MarkdownDescription: "Enable RSS flag.",
 				Optional:            true,
@@ -190,6 +192,9 @@ func (r *IndexerTorrentRssResource) Configure(ctx context.Context, req resource.
 }
 
 func (r *IndexerTorrentRssResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var indexer *IndexerTorrentRss
 
@@ -202,9 +207,9 @@ func (r *IndexerTorrentRssResource) Create(ctx context.Context, req resource.Cre
 	// Create new IndexerTorrentRss
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerTorrentRssResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, indexerTorrentRssResourceName, err)
 
 		return
 	}
@@ -216,6 +221,9 @@ func (r *IndexerTorrentRssResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *IndexerTorrentRssResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var indexer *IndexerTorrentRss
 
@@ -226,8 +234,12 @@ func (r *IndexerTorrentRssResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get IndexerTorrentRss current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, indexerTorrentRssResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerTorrentRssResourceName, err))
 
 		return
@@ -240,6 +252,9 @@ func (r *IndexerTorrentRssResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *IndexerTorrentRssResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var indexer *IndexerTorrentRss
 
@@ -252,9 +267,9 @@ func (r *IndexerTorrentRssResource) Update(ctx context.Context, req resource.Upd
 	// Update IndexerTorrentRss
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(ctx, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerTorrentRssResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, indexerTorrentRssResourceName, err)
 
 		return
 	}
@@ -266,6 +281,9 @@ func (r *IndexerTorrentRssResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *IndexerTorrentRssResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -275,7 +293,7 @@ func (r *IndexerTorrentRssResource) Delete(ctx context.Context, req resource.Del
 	}
 
 	// Delete IndexerTorrentRss current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerTorrentRssResourceName, err))
 
@@ -287,7 +305,7 @@ func (r *IndexerTorrentRssResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *IndexerTorrentRssResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), indexerImportLookups(r.auth.apiContext(ctx), r.client, indexerTorrentRssImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+indexerTorrentRssResourceName+": "+req.ID)
 }
 
@@ -24,7 +24,7 @@ func NewIndexersDataSource() datasource.DataSource {
 // IndexersDataSource defines the indexers implementation.
 type IndexersDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // Indexers describes the indexers data model.
@@ -205,8 +205,10 @@ func (d *IndexersDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *IndexersDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get indexers current value
-	response, _, err := d.client.IndexerAPI.ListIndexer(d.auth).Execute()
+	response, _, err := d.client.IndexerAPI.ListIndexer(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, indexersDataSourceName, err))
 
@@ -25,7 +25,7 @@ func NewLanguageDataSource() datasource.DataSource {
 // LanguageDataSource defines the language implementation.
 type LanguageDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // Language defines the language data model.
@@ -76,6 +76,8 @@ func (d *LanguageDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *LanguageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	var data *Language
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -85,7 +87,7 @@ func (d *LanguageDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	// Get languages current value
-	response, _, err := d.client.LanguageAPI.ListLanguage(d.auth).Execute()
+	response, _, err := d.client.LanguageAPI.ListLanguage(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, languageDataSourceName, err))
 
@@ -24,7 +24,7 @@ func NewLanguagesDataSource() datasource.DataSource {
 // LanguagesDataSource defines the languages implementation.
 type LanguagesDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // Languages describes the languages data model.
@@ -77,8 +77,10 @@ func (d *LanguagesDataSource) Configure(ctx context.Context, req datasource.Conf
 }
 
 func (d *LanguagesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get languages current value
-	response, _, err := d.client.LanguageAPI.ListLanguage(d.auth).Execute()
+	response, _, err := d.client.LanguageAPI.ListLanguage(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, languagesDataSourceName, err))
 
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const manualImportCandidatesDataSourceName = "manual_import_candidates"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ManualImportCandidatesDataSource{}
+
+func NewManualImportCandidatesDataSource() datasource.DataSource {
+	return &ManualImportCandidatesDataSource{}
+}
+
+// ManualImportCandidatesDataSource defines the manual import candidates implementation.
+type ManualImportCandidatesDataSource struct {
+	client *radarr.APIClient
+	auth   radarrAuth
+}
+
+// ManualImportCandidates describes the manual import candidates data model.
+type ManualImportCandidates struct {
+	Candidates          types.List   `tfsdk:"candidates"`
+	ID                  types.String `tfsdk:"id"`
+	Folder              types.String `tfsdk:"folder"`
+	DownloadID          types.String `tfsdk:"download_id"`
+	MovieID             types.Int64  `tfsdk:"movie_id"`
+	FilterExistingFiles types.Bool   `tfsdk:"filter_existing_files"`
+}
+
+// ManualImportCandidate is part of ManualImportCandidates.
+type ManualImportCandidate struct {
+	Languages         types.Set    `tfsdk:"languages"`
+	Rejections        types.List   `tfsdk:"rejections"`
+	Path              types.String `tfsdk:"path"`
+	RelativePath      types.String `tfsdk:"relative_path"`
+	FolderName        types.String `tfsdk:"folder_name"`
+	Name              types.String `tfsdk:"name"`
+	MovieTitle        types.String `tfsdk:"movie_title"`
+	Quality           types.String `tfsdk:"quality"`
+	ReleaseGroup      types.String `tfsdk:"release_group"`
+	DownloadID        types.String `tfsdk:"download_id"`
+	Size              types.Int64  `tfsdk:"size"`
+	MovieID           types.Int64  `tfsdk:"movie_id"`
+	QualityID         types.Int64  `tfsdk:"quality_id"`
+	CustomFormatScore types.Int64  `tfsdk:"custom_format_score"`
+}
+
+func (c ManualImportCandidate) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"languages":           types.SetType{}.WithElementType(types.StringType),
+			"rejections":          types.ListType{}.WithElementType(types.StringType),
+			"path":                types.StringType,
+			"relative_path":       types.StringType,
+			"folder_name":         types.StringType,
+			"name":                types.StringType,
+			"movie_title":         types.StringType,
+			"quality":             types.StringType,
+			"release_group":       types.StringType,
+			"download_id":         types.StringType,
+			"size":                types.Int64Type,
+			"movie_id":            types.Int64Type,
+			"quality_id":          types.Int64Type,
+			"custom_format_score": types.Int64Type,
+		})
+}
+
+func (d *ManualImportCandidatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + manualImportCandidatesDataSourceName
+}
+
+func (d *ManualImportCandidatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Movies -->\nFiles available for a [Manual Import](../resources/manual_import), as detected by Radarr in a folder or download.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"folder": schema.StringAttribute{
+				MarkdownDescription: "Folder to scan. Either `folder` or `download_id` must be set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("download_id")),
+				},
+			},
+			"download_id": schema.StringAttribute{
+				MarkdownDescription: "Download client ID of the download to scan.",
+				Optional:            true,
+			},
+			"movie_id": schema.Int64Attribute{
+				MarkdownDescription: "Movie ID the files belong to. Detected from the file names if not set.",
+				Optional:            true,
+			},
+			"filter_existing_files": schema.BoolAttribute{
+				MarkdownDescription: "Skip files already in the library. Defaults to `true`.",
+				Optional:            true,
+			},
+			"candidates": schema.ListNestedAttribute{
+				MarkdownDescription: "Candidate list, sorted by path.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Full path.",
+							Computed:            true,
+						},
+						"relative_path": schema.StringAttribute{
+							MarkdownDescription: "Path relative to the scanned folder.",
+							Computed:            true,
+						},
+						"folder_name": schema.StringAttribute{
+							MarkdownDescription: "Folder name.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "File name.",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Size in bytes.",
+							Computed:            true,
+						},
+						"movie_id": schema.Int64Attribute{
+							MarkdownDescription: "Detected movie ID, `0` if no movie matches.",
+							Computed:            true,
+						},
+						"movie_title": schema.StringAttribute{
+							MarkdownDescription: "Detected movie title.",
+							Computed:            true,
+						},
+						"quality_id": schema.Int64Attribute{
+							MarkdownDescription: "Detected quality ID.",
+							Computed:            true,
+						},
+						"quality": schema.StringAttribute{
+							MarkdownDescription: "Detected quality name.",
+							Computed:            true,
+						},
+						"languages": schema.SetAttribute{
+							MarkdownDescription: "Detected language names.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"release_group": schema.StringAttribute{
+							MarkdownDescription: "Detected release group.",
+							Computed:            true,
+						},
+						"download_id": schema.StringAttribute{
+							MarkdownDescription: "Download client ID.",
+							Computed:            true,
+						},
+						"custom_format_score": schema.Int64Attribute{
+							MarkdownDescription: "Custom format score.",
+							Computed:            true,
+						},
+						"rejections": schema.ListAttribute{
+							MarkdownDescription: "Reasons preventing an automatic import.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ManualImportCandidatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *ManualImportCandidatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
+	var data *ManualImportCandidates
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := d.client.ManualImportAPI.ListManualImport(ctx)
+
+	if !data.Folder.IsNull() {
+		request = request.Folder(data.Folder.ValueString())
+	}
+
+	if !data.DownloadID.IsNull() {
+		request = request.DownloadId(data.DownloadID.ValueString())
+	}
+
+	if !data.MovieID.IsNull() {
+		request = request.MovieId(int32(data.MovieID.ValueInt64()))
+	}
+
+	if !data.FilterExistingFiles.IsNull() {
+		request = request.FilterExistingFiles(data.FilterExistingFiles.ValueBool())
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, manualImportCandidatesDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+manualImportCandidatesDataSourceName)
+	// Map response body to resource schema attribute
+	data.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (m *ManualImportCandidates) write(ctx context.Context, candidates []radarr.ManualImportResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	slices.SortStableFunc(candidates, func(a, b radarr.ManualImportResource) int {
+		return cmp.Compare(a.GetPath(), b.GetPath())
+	})
+
+	list := make([]ManualImportCandidate, len(candidates))
+	for i, c := range candidates {
+		list[i].write(ctx, &c, diags)
+	}
+
+	m.ID = types.StringValue(strconv.Itoa(len(candidates)))
+	m.Candidates, tempDiag = types.ListValueFrom(ctx, ManualImportCandidate{}.getType(), list)
+	diags.Append(tempDiag...)
+}
+
+func (c *ManualImportCandidate) write(ctx context.Context, candidate *radarr.ManualImportResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	movie := candidate.GetMovie()
+	quality := candidate.GetQuality()
+
+	languages := make([]string, len(candidate.GetLanguages()))
+	for i, l := range candidate.GetLanguages() {
+		languages[i] = l.GetName()
+	}
+
+	rejections := make([]string, len(candidate.GetRejections()))
+	for i, r := range candidate.GetRejections() {
+		rejections[i] = r.GetReason()
+	}
+
+	c.Path = types.StringValue(candidate.GetPath())
+	c.RelativePath = types.StringValue(candidate.GetRelativePath())
+	c.FolderName = types.StringValue(candidate.GetFolderName())
+	c.Name = types.StringValue(candidate.GetName())
+	c.Size = types.Int64Value(candidate.GetSize())
+	c.MovieID = types.Int64Value(int64(movie.GetId()))
+	c.MovieTitle = types.StringValue(movie.GetTitle())
+	c.QualityID = types.Int64Value(int64(quality.Quality.GetId()))
+	c.Quality = types.StringValue(quality.Quality.GetName())
+	c.ReleaseGroup = types.StringValue(candidate.GetReleaseGroup())
+	c.DownloadID = types.StringValue(candidate.GetDownloadId())
+	c.CustomFormatScore = types.Int64Value(int64(candidate.GetCustomFormatScore()))
+	c.Languages, tempDiag = types.SetValueFrom(ctx, types.StringType, languages)
+	diags.Append(tempDiag...)
+	c.Rejections, tempDiag = types.ListValueFrom(ctx, types.StringType, rejections)
+	diags.Append(tempDiag...)
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccManualImportCandidatesDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccManualImportCandidatesDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Missing source
+			{
+				Config: `
+				data "radarr_manual_import_candidates" "test" {
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Read testing
+			{
+				Config: testAccManualImportCandidatesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.radarr_manual_import_candidates.test", "id"),
+					resource.TestCheckResourceAttr("data.radarr_manual_import_candidates.test", "candidates.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccManualImportCandidatesDataSourceConfig = `
+data "radarr_manual_import_candidates" "test" {
+	folder = "/config"
+}
+`
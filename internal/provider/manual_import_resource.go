@@ -0,0 +1,509 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	manualImportResourceName = "manual_import"
+	manualImportCommand      = "ManualImport"
+	manualImportImported     = "imported"
+	manualImportFailed       = "failed"
+)
+
+var (
+	errQualityNotFound  = errors.New("no quality found")
+	errLanguageNotFound = errors.New("no language found")
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ManualImportResource{}
+
+func NewManualImportResource() resource.Resource {
+	return &ManualImportResource{}
+}
+
+// ManualImportResource defines the manual import implementation.
+type ManualImportResource struct {
+	client *radarr.APIClient
+	auth   radarrAuth
+}
+
+// ManualImport describes the manual import data model.
+type ManualImport struct {
+	Files      types.List   `tfsdk:"files"`
+	Results    types.List   `tfsdk:"results"`
+	Timeouts   types.Object `tfsdk:"timeouts"`
+	ImportMode types.String `tfsdk:"import_mode"`
+	Status     types.String `tfsdk:"status"`
+	ID         types.Int64  `tfsdk:"id"`
+}
+
+// ManualImportFile is part of ManualImport.
+type ManualImportFile struct {
+	Languages    types.Set    `tfsdk:"languages"`
+	Path         types.String `tfsdk:"path"`
+	ReleaseGroup types.String `tfsdk:"release_group"`
+	DownloadID   types.String `tfsdk:"download_id"`
+	MovieID      types.Int64  `tfsdk:"movie_id"`
+	QualityID    types.Int64  `tfsdk:"quality_id"`
+}
+
+// ManualImportResult is part of ManualImport.
+type ManualImportResult struct {
+	Path        types.String `tfsdk:"path"`
+	Status      types.String `tfsdk:"status"`
+	MovieID     types.Int64  `tfsdk:"movie_id"`
+	MovieFileID types.Int64  `tfsdk:"movie_file_id"`
+}
+
+func (r ManualImportResult) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"path":          types.StringType,
+			"status":        types.StringType,
+			"movie_id":      types.Int64Type,
+			"movie_file_id": types.Int64Type,
+		})
+}
+
+func (r *ManualImportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + manualImportResourceName
+}
+
+func (r *ManualImportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Movies -->\nManual Import resource.\nImports files into movies and waits for the import to complete, values not given are the ones detected by Radarr, see [Manual Import Candidates](../data-sources/manual_import_candidates). The apply fails if the import fails.\nFiles are imported again when any attribute changes.\nDestroying the resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Import command ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"import_mode": schema.StringAttribute{
+				MarkdownDescription: "Import mode. Valid values are: 'move' and 'copy'. Defaults to 'move'.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("move"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("move", "copy"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"files": schema.ListNestedAttribute{
+				MarkdownDescription: "Files to import.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Full path.",
+							Required:            true,
+						},
+						"movie_id": schema.Int64Attribute{
+							MarkdownDescription: "Movie ID.",
+							Required:            true,
+						},
+						"quality_id": schema.Int64Attribute{
+							MarkdownDescription: "Quality ID. Defaults to the detected one.",
+							Optional:            true,
+						},
+						"languages": schema.SetAttribute{
+							MarkdownDescription: "Language names. Default to the detected ones.",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
+						"release_group": schema.StringAttribute{
+							MarkdownDescription: "Release group. Defaults to the detected one.",
+							Optional:            true,
+						},
+						"download_id": schema.StringAttribute{
+							MarkdownDescription: "Download client ID. Defaults to the detected one.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Final import command status.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				MarkdownDescription: "Import outcome of each file, in the `files` order.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Full path.",
+							Computed:            true,
+						},
+						"movie_id": schema.Int64Attribute{
+							MarkdownDescription: "Movie ID.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Outcome, either 'imported' or 'failed'.",
+							Computed:            true,
+						},
+						"movie_file_id": schema.Int64Attribute{
+							MarkdownDescription: "Imported movie file ID, `0` if the import failed.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ManualImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *ManualImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
+	var manualImport *ManualImport
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &manualImport)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var files []ManualImportFile
+
+	resp.Diagnostics.Append(manualImport.Files.ElementsAs(ctx, &files, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the import request from the detected values and the given overrides
+	payload := map[string]interface{}{
+		"name":       manualImportCommand,
+		"importMode": manualImport.ImportMode.ValueString(),
+		"files":      r.importFiles(ctx, files, &resp.Diagnostics),
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Send the import command
+	response, err := sendCommand(ctx, r.client, r.auth, payload)
+	if err != nil {
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, manualImportResourceName, err)
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+manualImportResourceName+": "+strconv.Itoa(int(response.GetId())))
+
+	// Wait for the import to complete
+	response, err = waitCommand(ctx, r.client, r.auth, response)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, manualImportResourceName, err))
+
+		return
+	}
+
+	// Match the imported files with the movie files added by the command
+	results := r.results(ctx, files, response.GetStarted(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate resource state struct, a failed import is saved to be run again
+	manualImport.write(ctx, response, results, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &manualImport)...)
+
+	if !commandSucceeded(response) {
+		resp.Diagnostics.AddError(helpers.ResourceError, fmt.Sprintf("Manual import %s: %s", response.GetStatus(), response.GetMessage()))
+
+		return
+	}
+
+	for _, result := range results {
+		if result.Status.ValueString() == manualImportFailed {
+			resp.Diagnostics.AddWarning(helpers.ResourceError, "File not imported: "+result.Path.ValueString())
+		}
+	}
+}
+
+func (r *ManualImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Imports are one shot, the outcome is kept as is
+	var manualImport *ManualImport
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &manualImport)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+manualImportResourceName+": "+strconv.Itoa(int(manualImport.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &manualImport)...)
+}
+
+func (r *ManualImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only timeouts can be updated without importing again
+	var manualImport *ManualImport
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &manualImport)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+manualImportResourceName+": "+strconv.Itoa(int(manualImport.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &manualImport)...)
+}
+
+func (r *ManualImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Imported files cannot be restored just removing configuration
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "decoupled "+manualImportResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+// importFiles builds the command files, starting from the values Radarr detects for each path.
+func (r *ManualImportResource) importFiles(ctx context.Context, files []ManualImportFile, diags *diag.Diagnostics) []map[string]interface{} {
+	var (
+		candidates []radarr.ManualImportResource
+		qualities  []radarr.QualityDefinitionResource
+		languages  []radarr.LanguageResource
+		err        error
+	)
+
+	result := make([]map[string]interface{}, len(files))
+
+	for i, file := range files {
+		candidates, _, err = r.client.ManualImportAPI.ListManualImport(ctx).
+			Folder(file.Path.ValueString()).
+			MovieId(int32(file.MovieID.ValueInt64())).
+			FilterExistingFiles(false).
+			Execute()
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, manualImportCandidatesDataSourceName, err))
+
+			return nil
+		}
+
+		if len(candidates) == 0 {
+			diags.AddAttributeError(path.Root("files").AtListIndex(i).AtName("path"), helpers.ValidationError, "no importable file found at "+file.Path.ValueString())
+
+			return nil
+		}
+
+		candidate := candidates[0]
+		importFile := map[string]interface{}{
+			"path":         file.Path.ValueString(),
+			"movieId":      file.MovieID.ValueInt64(),
+			"quality":      candidate.GetQuality(),
+			"languages":    candidate.GetLanguages(),
+			"releaseGroup": candidate.GetReleaseGroup(),
+			"downloadId":   candidate.GetDownloadId(),
+			"indexerFlags": candidate.GetIndexerFlags(),
+		}
+
+		if !file.ReleaseGroup.IsNull() {
+			importFile["releaseGroup"] = file.ReleaseGroup.ValueString()
+		}
+
+		if !file.DownloadID.IsNull() {
+			importFile["downloadId"] = file.DownloadID.ValueString()
+		}
+
+		if !file.QualityID.IsNull() {
+			if qualities == nil {
+				if qualities, _, err = r.client.QualityDefinitionAPI.ListQualityDefinition(ctx).Execute(); err != nil {
+					diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, qualityDefinitionResourceName, err))
+
+					return nil
+				}
+			}
+
+			quality := candidate.GetQuality()
+			if quality.Quality, err = findQuality(qualities, file.QualityID.ValueInt64()); err != nil {
+				diags.AddAttributeError(path.Root("files").AtListIndex(i).AtName("quality_id"), helpers.ValidationError, err.Error())
+
+				return nil
+			}
+
+			importFile["quality"] = quality
+		}
+
+		if !file.Languages.IsNull() {
+			if languages == nil {
+				if languages, _, err = r.client.LanguageAPI.ListLanguage(ctx).Execute(); err != nil {
+					diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, languageDataSourceName, err))
+
+					return nil
+				}
+			}
+
+			var names []string
+
+			diags.Append(file.Languages.ElementsAs(ctx, &names, false)...)
+
+			if importFile["languages"], err = findLanguages(languages, names); err != nil {
+				diags.AddAttributeError(path.Root("files").AtListIndex(i).AtName("languages"), helpers.ValidationError, err.Error())
+
+				return nil
+			}
+		}
+
+		result[i] = importFile
+	}
+
+	return result
+}
+
+// results lists the movie files of the imported movies and matches them with the files.
+func (r *ManualImportResource) results(ctx context.Context, files []ManualImportFile, started time.Time, diags *diag.Diagnostics) []ManualImportResult {
+	movieFiles := make(map[int64][]radarr.MovieFileResource)
+
+	for _, file := range files {
+		movieID := file.MovieID.ValueInt64()
+		if _, ok := movieFiles[movieID]; ok {
+			continue
+		}
+
+		response, _, err := r.client.MovieFileAPI.ListMovieFile(ctx).MovieId([]int32{int32(movieID)}).Execute()
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, movieResourceName, err))
+
+			return nil
+		}
+
+		movieFiles[movieID] = response
+	}
+
+	return manualImportResults(files, movieFiles, started)
+}
+
+// manualImportResults reports each file as imported when a movie file of its movie was imported from it
+// once the command started, so that older files with the same name are not matched.
+func manualImportResults(files []ManualImportFile, movieFiles map[int64][]radarr.MovieFileResource, started time.Time) []ManualImportResult {
+	results := make([]ManualImportResult, len(files))
+
+	for i, file := range files {
+		results[i] = ManualImportResult{
+			Path:        file.Path,
+			Status:      types.StringValue(manualImportFailed),
+			MovieID:     file.MovieID,
+			MovieFileID: types.Int64Value(0),
+		}
+
+		for _, m := range movieFiles[file.MovieID.ValueInt64()] {
+			if fileName(m.GetOriginalFilePath()) == fileName(file.Path.ValueString()) && !m.GetDateAdded().Before(started) {
+				results[i].Status = types.StringValue(manualImportImported)
+				results[i].MovieFileID = types.Int64Value(int64(m.GetId()))
+			}
+		}
+	}
+
+	return results
+}
+
+func (m *ManualImport) write(ctx context.Context, command *radarr.CommandResource, results []ManualImportResult, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	m.ID = types.Int64Value(int64(command.GetId()))
+	m.Status = types.StringValue(string(command.GetStatus()))
+	m.Results, tempDiag = types.ListValueFrom(ctx, ManualImportResult{}.getType(), results)
+	diags.Append(tempDiag...)
+}
+
+// findQuality looks up a quality by ID.
+func findQuality(definitions []radarr.QualityDefinitionResource, id int64) (*radarr.Quality, error) {
+	for _, d := range definitions {
+		if quality := d.GetQuality(); int64(quality.GetId()) == id {
+			return &quality, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w with ID %d", errQualityNotFound, id)
+}
+
+// findLanguages looks up languages by name.
+func findLanguages(languages []radarr.LanguageResource, names []string) ([]radarr.Language, error) {
+	result := make([]radarr.Language, 0, len(names))
+
+	for _, name := range names {
+		found := false
+
+		for _, l := range languages {
+			if strings.EqualFold(l.GetName(), name) {
+				language := radarr.NewLanguage()
+				language.SetId(l.GetId())
+				language.SetName(l.GetName())
+				result = append(result, *language)
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("%w named %s", errLanguageNotFound, name)
+		}
+	}
+
+	return result, nil
+}
+
+// fileName returns the last element of either a unix or a windows path.
+func fileName(filePath string) string {
+	return filePath[strings.LastIndexAny(filePath, `/\`)+1:]
+}
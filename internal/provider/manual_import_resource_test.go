@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccManualImportResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccMovieResourceConfig("Star Wars", "Star_Wars_1977", 11) + testAccManualImportResourceConfig("copy") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid mode
+			{
+				Config:      testAccMovieResourceConfig("Star Wars", "Star_Wars_1977", 11) + testAccManualImportResourceConfig("link"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Missing file
+			{
+				Config:      testAccMovieResourceConfig("Star Wars", "Star_Wars_1977", 11) + testAccManualImportResourceConfig("copy"),
+				ExpectError: regexp.MustCompile("no importable file found"),
+			},
+		},
+	})
+}
+
+func testAccManualImportResourceConfig(mode string) string {
+	return fmt.Sprintf(`
+	resource "radarr_manual_import" "test" {
+		import_mode = "%s"
+
+		files = [
+			{
+				path     = "/downloads/Star.Wars.1977.1080p.BluRay.x264-GROUP.mkv"
+				movie_id = radarr_movie.test.id
+			}
+		]
+	}`, mode)
+}
+
+func TestManualImportResults(t *testing.T) {
+	t.Parallel()
+
+	started := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	movieFile := func(id int32, originalPath string, added time.Time) radarr.MovieFileResource {
+		file := radarr.NewMovieFileResource()
+		file.SetId(id)
+		file.SetOriginalFilePath(originalPath)
+		file.SetDateAdded(added)
+
+		return *file
+	}
+
+	files := []ManualImportFile{
+		{Path: types.StringValue("/downloads/Movie.2010.mkv"), MovieID: types.Int64Value(1)},
+		{Path: types.StringValue(`C:\downloads\Other.2011.mkv`), MovieID: types.Int64Value(2)},
+		{Path: types.StringValue("/downloads/Old.2012.mkv"), MovieID: types.Int64Value(3)},
+	}
+	movieFiles := map[int64][]radarr.MovieFileResource{
+		1: {movieFile(10, "Movie.2010.mkv", started.Add(time.Second))},
+		2: {movieFile(20, "Other.2011.mkv", started)},
+		// imported before the command, e.g. by a previous run.
+		3: {movieFile(30, "Old.2012.mkv", started.Add(-time.Hour))},
+	}
+
+	expected := []ManualImportResult{
+		{Path: files[0].Path, MovieID: files[0].MovieID, Status: types.StringValue(manualImportImported), MovieFileID: types.Int64Value(10)},
+		{Path: files[1].Path, MovieID: files[1].MovieID, Status: types.StringValue(manualImportImported), MovieFileID: types.Int64Value(20)},
+		{Path: files[2].Path, MovieID: files[2].MovieID, Status: types.StringValue(manualImportFailed), MovieFileID: types.Int64Value(0)},
+	}
+
+	assert.Equal(t, expected, manualImportResults(files, movieFiles, started))
+}
+
+func TestManualImportFiles(t *testing.T) {
+	t.Parallel()
+
+	var definitionCalls int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v3/manualimport", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Query().Get("folder") == "/downloads/Missing.mkv" {
+			_, _ = w.Write([]byte(`[]`))
+
+			return
+		}
+
+		assert.Equal(t, "false", r.URL.Query().Get("filterExistingFiles"))
+		_, _ = w.Write([]byte(`[{"path":"` + r.URL.Query().Get("folder") + `","quality":{"quality":{"id":3,"name":"WEBDL-1080p"},"revision":{"version":2}},` +
+			`"languages":[{"id":1,"name":"English"}],"releaseGroup":"DETECTED","downloadId":"detected","indexerFlags":1}]`))
+	})
+	mux.HandleFunc("GET /api/v3/qualitydefinition", func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&definitionCalls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":1,"quality":{"id":3,"name":"WEBDL-1080p"}},{"id":2,"quality":{"id":7,"name":"Bluray-1080p"}}]`))
+	})
+	mux.HandleFunc("GET /api/v3/language", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":1,"name":"English"},{"id":4,"name":"German"}]`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	ctx := context.WithValue(context.Background(), radarr.ContextServerVariables, map[string]string{
+		"protocol": serverURL.Scheme,
+		"hostpath": serverURL.Host,
+	})
+	r := &ManualImportResource{client: radarr.NewAPIClient(radarr.NewConfiguration())}
+	file := func(filePath string) ManualImportFile {
+		return ManualImportFile{
+			Path:         types.StringValue(filePath),
+			MovieID:      types.Int64Value(11),
+			QualityID:    types.Int64Null(),
+			Languages:    types.SetNull(types.StringType),
+			ReleaseGroup: types.StringNull(),
+			DownloadID:   types.StringNull(),
+		}
+	}
+
+	overridden := file("/downloads/Movie.2010.mkv")
+	overridden.QualityID = types.Int64Value(7)
+	overridden.Languages = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("german"), types.StringValue("English")})
+	overridden.ReleaseGroup = types.StringValue("GROUP")
+	overridden.DownloadID = types.StringValue("download")
+	requalified := file("/downloads/Other.2011.mkv")
+	requalified.QualityID = types.Int64Value(3)
+
+	var diags diag.Diagnostics
+
+	payload := r.importFiles(ctx, []ManualImportFile{overridden, file("/downloads/Detected.2012.mkv"), requalified}, &diags)
+	assert.False(t, diags.HasError())
+
+	data, err := json.Marshal(payload)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{
+			"path": "/downloads/Movie.2010.mkv", "movieId": 11, "indexerFlags": 1,
+			"quality": {"quality": {"id": 7, "name": "Bluray-1080p"}, "revision": {"version": 2}},
+			"languages": [{"id": 4, "name": "German"}, {"id": 1, "name": "English"}],
+			"releaseGroup": "GROUP", "downloadId": "download"
+		},
+		{
+			"path": "/downloads/Detected.2012.mkv", "movieId": 11, "indexerFlags": 1,
+			"quality": {"quality": {"id": 3, "name": "WEBDL-1080p"}, "revision": {"version": 2}},
+			"languages": [{"id": 1, "name": "English"}],
+			"releaseGroup": "DETECTED", "downloadId": "detected"
+		},
+		{
+			"path": "/downloads/Other.2011.mkv", "movieId": 11, "indexerFlags": 1,
+			"quality": {"quality": {"id": 3, "name": "WEBDL-1080p"}, "revision": {"version": 2}},
+			"languages": [{"id": 1, "name": "English"}],
+			"releaseGroup": "DETECTED", "downloadId": "detected"
+		}
+	]`, string(data))
+	// the quality definitions are listed once for all the files.
+	assert.Equal(t, int32(1), atomic.LoadInt32(&definitionCalls))
+
+	// invalid overrides and missing files are reported on the file attributes.
+	unknownQuality := file("/downloads/Movie.2010.mkv")
+	unknownQuality.QualityID = types.Int64Value(99)
+	unknownLanguage := file("/downloads/Movie.2010.mkv")
+	unknownLanguage.Languages = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("Klingon")})
+
+	for expected, invalid := range map[string]ManualImportFile{
+		"files[1].quality_id": unknownQuality,
+		"files[1].languages":  unknownLanguage,
+		"files[1].path":       file("/downloads/Missing.mkv"),
+	} {
+		diags = nil
+
+		assert.Nil(t, r.importFiles(ctx, []ManualImportFile{file("/downloads/Detected.2012.mkv"), invalid}, &diags))
+		assert.True(t, diags.HasError(), expected)
+
+		if diags.HasError() {
+			withPath, _ := diags.Errors()[0].(diag.DiagnosticWithPath)
+			assert.Equal(t, expected, withPath.Path().String())
+		}
+	}
+}
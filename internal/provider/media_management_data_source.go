@@ -22,7 +22,7 @@ func NewMediaManagementDataSource() datasource.DataSource {
 // MediaManagementDataSource defines the media management implementation.
 type MediaManagementDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *MediaManagementDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -126,8 +126,10 @@ func (d *MediaManagementDataSource) Configure(ctx context.Context, req datasourc
 }
 
 func (d *MediaManagementDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get indexer config current value
-	response, _, err := d.client.MediaManagementConfigAPI.GetMediaManagementConfig(d.auth).Execute()
+	response, _, err := d.client.MediaManagementConfigAPI.GetMediaManagementConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, mediaManagementDataSourceName, err))
 
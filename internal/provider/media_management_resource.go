@@ -32,7 +32,7 @@ func NewMediaManagementResource() resource.Resource {
 // MediaManagementResource defines the media management implementation.
 type MediaManagementResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // MediaManagement describes the media management data model.
@@ -59,6 +59,12 @@ type MediaManagement struct {
 	AutoUnmonitorPreviouslyDownloadedMovies types.Bool   `tfsdk:"auto_unmonitor_previously_downloaded_movies"`
 }
 
+// MediaManagementResourceModel describes the media management resource data model.
+type MediaManagementResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	MediaManagement
+}
+
 func (r *MediaManagementResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + mediaManagementResourceName
 }
@@ -67,6 +73,7 @@ func (r *MediaManagementResource) Schema(_ context.Context, _ resource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Media Management -->\nMedia Management resource.\nFor more information refer to [Naming](https://wiki.servarr.com/radarr/settings#file-management) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Media Management ID.",
 				Computed:            true,
@@ -171,8 +178,11 @@ func (r *MediaManagementResource) Configure(ctx context.Context, req resource.Co
 }
 
 func (r *MediaManagementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var management *MediaManagement
+	var management *MediaManagementResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &management)...)
 
@@ -185,9 +195,9 @@ func (r *MediaManagementResource) Create(ctx context.Context, req resource.Creat
 	request.SetId(1)
 
 	// Create new MediaManagement
-	response, _, err := r.client.MediaManagementConfigAPI.UpdateMediaManagementConfig(r.auth, strconv.Itoa(int(request.GetId()))).MediaManagementConfigResource(*request).Execute()
+	response, _, err := r.client.MediaManagementConfigAPI.UpdateMediaManagementConfig(ctx, strconv.Itoa(int(request.GetId()))).MediaManagementConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, mediaManagementResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, mediaManagementResourceName, err)
 
 		return
 	}
@@ -199,8 +209,11 @@ func (r *MediaManagementResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *MediaManagementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var management *MediaManagement
+	var management *MediaManagementResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &management)...)
 
@@ -209,7 +222,7 @@ func (r *MediaManagementResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get mediamanagement current value
-	response, _, err := r.client.MediaManagementConfigAPI.GetMediaManagementConfig(r.auth).Execute()
+	response, _, err := r.client.MediaManagementConfigAPI.GetMediaManagementConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, mediaManagementResourceName, err))
 
@@ -223,8 +236,11 @@ func (r *MediaManagementResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *MediaManagementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var management *MediaManagement
+	var management *MediaManagementResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &management)...)
 
@@ -236,9 +252,9 @@ func (r *MediaManagementResource) Update(ctx context.Context, req resource.Updat
 	request := management.read()
 
 	// Update MediaManagement
-	response, _, err := r.client.MediaManagementConfigAPI.UpdateMediaManagementConfig(r.auth, strconv.Itoa(int(request.GetId()))).MediaManagementConfigResource(*request).Execute()
+	response, _, err := r.client.MediaManagementConfigAPI.UpdateMediaManagementConfig(ctx, strconv.Itoa(int(request.GetId()))).MediaManagementConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, mediaManagementResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, mediaManagementResourceName, err)
 
 		return
 	}
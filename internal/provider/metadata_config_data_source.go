@@ -22,7 +22,7 @@ func NewMetadataConfigDataSource() datasource.DataSource {
 // MetadataConfigDataSource defines the metadata config implementation.
 type MetadataConfigDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *MetadataConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -54,8 +54,10 @@ func (d *MetadataConfigDataSource) Configure(ctx context.Context, req datasource
 }
 
 func (d *MetadataConfigDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get metadata config current value
-	response, _, err := d.client.MetadataConfigAPI.GetMetadataConfig(d.auth).Execute()
+	response, _, err := d.client.MetadataConfigAPI.GetMetadataConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataConfigDataSourceName, err))
 
@@ -32,7 +32,7 @@ func NewMetadataConfigResource() resource.Resource {
 // MetadataConfigResource defines the metadata config implementation.
 type MetadataConfigResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // MetadataConfig describes the metadata config data model.
@@ -41,6 +41,12 @@ type MetadataConfig struct {
 	ID                   types.Int64  `tfsdk:"id"`
 }
 
+// MetadataConfigResourceModel describes the metadata config resource data model.
+type MetadataConfigResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	MetadataConfig
+}
+
 func (r *MetadataConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + metadataConfigResourceName
 }
@@ -49,6 +55,7 @@ func (r *MetadataConfigResource) Schema(_ context.Context, _ resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Metadata -->\nMetadata Config resource.\nFor more information refer to [Metadata](https://wiki.servarr.com/radarr/settings#options) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata Config ID.",
 				Computed:            true,
@@ -75,8 +82,11 @@ func (r *MetadataConfigResource) Configure(ctx context.Context, req resource.Con
 }
 
 func (r *MetadataConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var config *MetadataConfig
+	var config *MetadataConfigResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
 
@@ -89,9 +99,9 @@ func (r *MetadataConfigResource) Create(ctx context.Context, req resource.Create
 	request.SetId(1)
 
 	// Create new MetadataConfig
-	response, _, err := r.client.MetadataConfigAPI.UpdateMetadataConfig(r.auth, strconv.Itoa(int(request.GetId()))).MetadataConfigResource(*request).Execute()
+	response, _, err := r.client.MetadataConfigAPI.UpdateMetadataConfig(ctx, strconv.Itoa(int(request.GetId()))).MetadataConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataConfigResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, metadataConfigResourceName, err)
 
 		return
 	}
@@ -103,8 +113,11 @@ func (r *MetadataConfigResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *MetadataConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var config *MetadataConfig
+	var config *MetadataConfigResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
 
@@ -113,7 +126,7 @@ func (r *MetadataConfigResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get metadataConfig current value
-	response, _, err := r.client.MetadataConfigAPI.GetMetadataConfig(r.auth).Execute()
+	response, _, err := r.client.MetadataConfigAPI.GetMetadataConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataConfigResourceName, err))
 
@@ -127,8 +140,11 @@ func (r *MetadataConfigResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *MetadataConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var config *MetadataConfig
+	var config *MetadataConfigResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
 
@@ -140,9 +156,9 @@ func (r *MetadataConfigResource) Update(ctx context.Context, req resource.Update
 	request := config.read()
 
 	// Update MetadataConfig
-	response, _, err := r.client.MetadataConfigAPI.UpdateMetadataConfig(r.auth, strconv.Itoa(int(request.GetId()))).MetadataConfigResource(*request).Execute()
+	response, _, err := r.client.MetadataConfigAPI.UpdateMetadataConfig(ctx, strconv.Itoa(int(request.GetId()))).MetadataConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataConfigResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, metadataConfigResourceName, err)
 
 		return
 	}
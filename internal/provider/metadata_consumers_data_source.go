@@ -24,7 +24,7 @@ func NewMetadataConsumersDataSource() datasource.DataSource {
 // MetadataConsumersDataSource defines the metadataConsumers implementation.
 type MetadataConsumersDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // MetadataConsumers describes the metadataConsumers data model.
@@ -116,8 +116,10 @@ func (d *MetadataConsumersDataSource) Configure(ctx context.Context, req datasou
 }
 
 func (d *MetadataConsumersDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get metadataConsumers current value
-	response, _, err := d.client.MetadataAPI.ListMetadata(d.auth).Execute()
+	response, _, err := d.client.MetadataAPI.ListMetadata(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, metadataConsumersDataSourceName, err))
 
@@ -24,7 +24,7 @@ func NewMetadataDataSource() datasource.DataSource {
 // MetadataDataSource defines the metadata implementation.
 type MetadataDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *MetadataDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -98,6 +98,8 @@ func (d *MetadataDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *MetadataDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	var data *Metadata
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -106,7 +108,7 @@ func (d *MetadataDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 	// Get metadata current value
-	response, _, err := d.client.MetadataAPI.ListMetadata(d.auth).Execute()
+	response, _, err := d.client.MetadataAPI.ListMetadata(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataDataSourceName, err))
 
@@ -35,12 +35,13 @@ func NewMetadataEmbyResource() resource.Resource {
 // MetadataEmbyResource defines the Emby metadata implementation.
 type MetadataEmbyResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // MetadataEmby describes the Emby metadata data model.
 type MetadataEmby struct {
 	Tags          types.Set    `tfsdk:"tags"`
+	Timeouts      types.Object `tfsdk:"timeouts"`
 	Name          types.String `tfsdk:"name"`
 	ID            types.Int64  `tfsdk:"id"`
 	Enable        types.Bool   `tfsdk:"enable"`
@@ -75,6 +76,7 @@ func (r *MetadataEmbyResource) Schema(_ context.Context, _ resource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Metadata -->\nMetadata Emby resource.\nFor more information refer to [Metadata](https://wiki.servarr.com/radarr/settings#metadata) and [Emby](https://wiki.servarr.com/radarr/supported#mediabrowsermetadata).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -114,6 +116,9 @@ func (r *MetadataEmbyResource) Configure(ctx context.Context, req resource.Confi
 }
 
 func (r *MetadataEmbyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var metadata *MetadataEmby
 
@@ -126,9 +131,9 @@ func (r *MetadataEmbyResource) Create(ctx context.Context, req resource.CreateRe
 	// Create new MetadataEmby
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.CreateMetadata(ctx).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataEmbyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, metadataEmbyResourceName, err)
 
 		return
 	}
@@ -140,6 +145,9 @@ func (r *MetadataEmbyResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *MetadataEmbyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var metadata *MetadataEmby
 
@@ -150,8 +158,12 @@ func (r *MetadataEmbyResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get MetadataEmby current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, metadataEmbyResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataEmbyResourceName, err))
 
 		return
@@ -164,6 +176,9 @@ func (r *MetadataEmbyResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *MetadataEmbyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var metadata *MetadataEmby
 
@@ -176,9 +191,9 @@ func (r *MetadataEmbyResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update MetadataEmby
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(ctx, request.GetId()).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataEmbyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, metadataEmbyResourceName, err)
 
 		return
 	}
@@ -190,6 +205,9 @@ func (r *MetadataEmbyResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *MetadataEmbyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -199,7 +217,7 @@ func (r *MetadataEmbyResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	// Delete MetadataEmby current value
-	_, err := r.client.MetadataAPI.DeleteMetadata(r.auth, int32(ID)).Execute()
+	_, err := r.client.MetadataAPI.DeleteMetadata(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataEmbyResourceName, err))
 
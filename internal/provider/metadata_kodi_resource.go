@@ -35,12 +35,13 @@ func NewMetadataKodiResource() resource.Resource {
 // MetadataKodiResource defines the Kodi metadata implementation.
 type MetadataKodiResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // MetadataKodi describes the Kodi metadata data model.
 type MetadataKodi struct {
 	Tags                  types.Set    `tfsdk:"tags"`
+	Timeouts              types.Object `tfsdk:"timeouts"`
 	Name                  types.String `tfsdk:"name"`
 	ID                    types.Int64  `tfsdk:"id"`
 	MovieMetadataLanguage types.Int64  `tfsdk:"movie_metadata_language"`
@@ -90,6 +91,7 @@ func (r *MetadataKodiResource) Schema(_ context.Context, _ resource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Metadata -->\nMetadata Kodi resource.\nFor more information refer to [Metadata](https://wiki.servarr.com/radarr/settings#metadata) and [KODI](https://wiki.servarr.com/radarr/supported#xbmcmetadata).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -149,6 +151,9 @@ func (r *MetadataKodiResource) Configure(ctx context.Context, req resource.Confi
 }
 
 func (r *MetadataKodiResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var metadata *MetadataKodi
 
@@ -161,9 +166,9 @@ func (r *MetadataKodiResource) Create(ctx context.Context, req resource.CreateRe
 	// Create new MetadataKodi
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.CreateMetadata(ctx).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataKodiResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, metadataKodiResourceName, err)
 
 		return
 	}
@@ -175,6 +180,9 @@ func (r *MetadataKodiResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *MetadataKodiResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var metadata *MetadataKodi
 
@@ -185,8 +193,12 @@ func (r *MetadataKodiResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get MetadataKodi current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, metadataKodiResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataKodiResourceName, err))
 
 		return
@@ -199,6 +211,9 @@ func (r *MetadataKodiResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *MetadataKodiResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var metadata *MetadataKodi
 
@@ -211,9 +226,9 @@ func (r *MetadataKodiResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update MetadataKodi
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(ctx, request.GetId()).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataKodiResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, metadataKodiResourceName, err)
 
 		return
 	}
@@ -225,6 +240,9 @@ func (r *MetadataKodiResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *MetadataKodiResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -234,7 +252,7 @@ func (r *MetadataKodiResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	// Delete MetadataKodi current value
-	_, err := r.client.MetadataAPI.DeleteMetadata(r.auth, int32(ID)).Execute()
+	_, err := r.client.MetadataAPI.DeleteMetadata(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataKodiResourceName, err))
 
@@ -37,7 +37,7 @@ func NewMetadataResource() resource.Resource {
 // MetadataResource defines the metadata implementation.
 type MetadataResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // Metadata describes the metadata data model.
@@ -56,6 +56,12 @@ type Metadata struct {
 	AddCollectionName     types.Bool   `tfsdk:"add_collection_name"`
 }
 
+// MetadataResourceModel describes the metadata resource data model.
+type MetadataResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	Metadata
+}
+
 func (m Metadata) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -82,6 +88,7 @@ func (r *MetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Metadata -->\nGeneric Metadata resource. When possible use a specific resource instead.\nFor more information refer to [Metadata](https://wiki.servarr.com/radarr/settings#metadata) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -155,8 +162,11 @@ func (r *MetadataResource) Configure(ctx context.Context, req resource.Configure
 }
 
 func (r *MetadataResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var metadata *Metadata
+	var metadata *MetadataResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &metadata)...)
 
@@ -167,9 +177,9 @@ func (r *MetadataResource) Create(ctx context.Context, req resource.CreateReques
 	// Create new Metadata
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.CreateMetadata(ctx).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, metadataResourceName, err)
 
 		return
 	}
@@ -177,15 +187,19 @@ func (r *MetadataResource) Create(ctx context.Context, req resource.CreateReques
 	tflog.Trace(ctx, "created "+metadataResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Metadata
+	var state MetadataResourceModel
 
+	state.Timeouts = metadata.Timeouts
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *MetadataResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var metadata *Metadata
+	var metadata *MetadataResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &metadata)...)
 
@@ -194,8 +208,12 @@ func (r *MetadataResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Get Metadata current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, metadataResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataResourceName, err))
 
 		return
@@ -204,15 +222,19 @@ func (r *MetadataResource) Read(ctx context.Context, req resource.ReadRequest, r
 	tflog.Trace(ctx, "read "+metadataResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Metadata
+	var state MetadataResourceModel
 
+	state.Timeouts = metadata.Timeouts
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var metadata *Metadata
+	var metadata *MetadataResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &metadata)...)
 
@@ -223,9 +245,9 @@ func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateReques
 	// Update Metadata
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(ctx, request.GetId()).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, metadataResourceName, err)
 
 		return
 	}
@@ -233,13 +255,17 @@ func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateReques
 	tflog.Trace(ctx, "updated "+metadataResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Metadata
+	var state MetadataResourceModel
 
+	state.Timeouts = metadata.Timeouts
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *MetadataResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -249,7 +275,7 @@ func (r *MetadataResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	// Delete Metadata current value
-	_, err := r.client.MetadataAPI.DeleteMetadata(r.auth, int32(ID)).Execute()
+	_, err := r.client.MetadataAPI.DeleteMetadata(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataResourceName, err))
 
@@ -35,12 +35,13 @@ func NewMetadataRoksboxResource() resource.Resource {
 // MetadataRoksboxResource defines the Roksbox metadata implementation.
 type MetadataRoksboxResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // MetadataRoksbox describes the Roksbox metadata data model.
 type MetadataRoksbox struct {
 	Tags          types.Set    `tfsdk:"tags"`
+	Timeouts      types.Object `tfsdk:"timeouts"`
 	Name          types.String `tfsdk:"name"`
 	ID            types.Int64  `tfsdk:"id"`
 	Enable        types.Bool   `tfsdk:"enable"`
@@ -78,6 +79,7 @@ func (r *MetadataRoksboxResource) Schema(_ context.Context, _ resource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Metadata -->\nMetadata Roksbox resource.\nFor more information refer to [Metadata](https://wiki.servarr.com/radarr/settings#metadata) and [ROKSBOX](https://wiki.servarr.com/radarr/supported#roksboxmetadata).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -121,6 +123,9 @@ func (r *MetadataRoksboxResource) Configure(ctx context.Context, req resource.Co
 }
 
 func (r *MetadataRoksboxResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var metadata *MetadataRoksbox
 
@@ -133,9 +138,9 @@ func (r *MetadataRoksboxResource) Create(ctx context.Context, req resource.Creat
 	// Create new MetadataRoksbox
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.CreateMetadata(ctx).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataRoksboxResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, metadataRoksboxResourceName, err)
 
 		return
 	}
@@ -147,6 +152,9 @@ func (r *MetadataRoksboxResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *MetadataRoksboxResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var metadata *MetadataRoksbox
 
@@ -157,8 +165,12 @@ func (r *MetadataRoksboxResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get MetadataRoksbox current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, metadataRoksboxResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataRoksboxResourceName, err))
 
 		return
@@ -171,6 +183,9 @@ func (r *MetadataRoksboxResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *MetadataRoksboxResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var metadata *MetadataRoksbox
 
@@ -183,9 +198,9 @@ func (r *MetadataRoksboxResource) Update(ctx context.Context, req resource.Updat
 	// Update MetadataRoksbox
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(ctx, request.GetId()).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataRoksboxResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, metadataRoksboxResourceName, err)
 
 		return
 	}
@@ -197,6 +212,9 @@ func (r *MetadataRoksboxResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *MetadataRoksboxResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -206,7 +224,7 @@ func (r *MetadataRoksboxResource) Delete(ctx context.Context, req resource.Delet
 	}
 
 	// Delete MetadataRoksbox current value
-	_, err := r.client.MetadataAPI.DeleteMetadata(r.auth, int32(ID)).Execute()
+	_, err := r.client.MetadataAPI.DeleteMetadata(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataRoksboxResourceName, err))
 
@@ -35,12 +35,13 @@ func NewMetadataWdtvResource() resource.Resource {
 // MetadataWdtvResource defines the Wdtv metadata implementation.
 type MetadataWdtvResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // MetadataWdtv describes the Wdtv metadata data model.
 type MetadataWdtv struct {
 	Tags          types.Set    `tfsdk:"tags"`
+	Timeouts      types.Object `tfsdk:"timeouts"`
 	Name          types.String `tfsdk:"name"`
 	ID            types.Int64  `tfsdk:"id"`
 	Enable        types.Bool   `tfsdk:"enable"`
@@ -78,6 +79,7 @@ func (r *MetadataWdtvResource) Schema(_ context.Context, _ resource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Metadata -->\nMetadata Wdtv resource.\nFor more information refer to [Metadata](https://wiki.servarr.com/radarr/settings#metadata) and [WDTV](https://wiki.servarr.com/radarr/supported#wdtvmetadata).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -121,6 +123,9 @@ func (r *MetadataWdtvResource) Configure(ctx context.Context, req resource.Confi
 }
 
 func (r *MetadataWdtvResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var metadata *MetadataWdtv
 
@@ -133,9 +138,9 @@ func (r *MetadataWdtvResource) Create(ctx context.Context, req resource.CreateRe
 	// Create new MetadataWdtv
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.CreateMetadata(ctx).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataWdtvResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, metadataWdtvResourceName, err)
 
 		return
 	}
@@ -147,6 +152,9 @@ func (r *MetadataWdtvResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *MetadataWdtvResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var metadata *MetadataWdtv
 
@@ -157,8 +165,12 @@ func (r *MetadataWdtvResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get MetadataWdtv current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, metadataWdtvResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataWdtvResourceName, err))
 
 		return
@@ -171,6 +183,9 @@ func (r *MetadataWdtvResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *MetadataWdtvResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var metadata *MetadataWdtv
 
@@ -183,9 +198,9 @@ func (r *MetadataWdtvResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update MetadataWdtv
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(ctx, request.GetId()).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataWdtvResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, metadataWdtvResourceName, err)
 
 		return
 	}
@@ -197,6 +212,9 @@ func (r *MetadataWdtvResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *MetadataWdtvResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -206,7 +224,7 @@ func (r *MetadataWdtvResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	// Delete MetadataWdtv current value
-	_, err := r.client.MetadataAPI.DeleteMetadata(r.auth, int32(ID)).Execute()
+	_, err := r.client.MetadataAPI.DeleteMetadata(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataWdtvResourceName, err))
 
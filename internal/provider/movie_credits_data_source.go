@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const movieCreditsDataSourceName = "movie_credits"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MovieCreditsDataSource{}
+
+func NewMovieCreditsDataSource() datasource.DataSource {
+	return &MovieCreditsDataSource{}
+}
+
+// MovieCreditsDataSource defines the movie credits implementation.
+type MovieCreditsDataSource struct {
+	client *radarr.APIClient
+	auth   radarrAuth
+}
+
+// MovieCredits describes the movie credits data model.
+type MovieCredits struct {
+	Cast    types.List   `tfsdk:"cast"`
+	Crew    types.List   `tfsdk:"crew"`
+	ID      types.String `tfsdk:"id"`
+	MovieID types.Int64  `tfsdk:"movie_id"`
+}
+
+// CastCredit is part of MovieCredits.
+type CastCredit struct {
+	Name         types.String `tfsdk:"name"`
+	Character    types.String `tfsdk:"character"`
+	Order        types.Int64  `tfsdk:"order"`
+	PersonTMDBID types.Int64  `tfsdk:"person_tmdb_id"`
+}
+
+// CrewCredit is part of MovieCredits.
+type CrewCredit struct {
+	Name         types.String `tfsdk:"name"`
+	Department   types.String `tfsdk:"department"`
+	Job          types.String `tfsdk:"job"`
+	PersonTMDBID types.Int64  `tfsdk:"person_tmdb_id"`
+}
+
+func (c CastCredit) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"name":           types.StringType,
+			"character":      types.StringType,
+			"order":          types.Int64Type,
+			"person_tmdb_id": types.Int64Type,
+		})
+}
+
+func (c CrewCredit) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"name":           types.StringType,
+			"department":     types.StringType,
+			"job":            types.StringType,
+			"person_tmdb_id": types.Int64Type,
+		})
+}
+
+func (d *MovieCreditsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + movieCreditsDataSourceName
+}
+
+func (d *MovieCreditsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Movies -->\nCast and crew of a [Movie](../resources/movie).",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"movie_id": schema.Int64Attribute{
+				MarkdownDescription: "Movie ID.",
+				Required:            true,
+			},
+			"cast": schema.ListNestedAttribute{
+				MarkdownDescription: "Cast list, sorted by billing order.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Person name.",
+							Computed:            true,
+						},
+						"character": schema.StringAttribute{
+							MarkdownDescription: "Character name.",
+							Computed:            true,
+						},
+						"order": schema.Int64Attribute{
+							MarkdownDescription: "Billing order.",
+							Computed:            true,
+						},
+						"person_tmdb_id": schema.Int64Attribute{
+							MarkdownDescription: "TMDB person ID.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"crew": schema.ListNestedAttribute{
+				MarkdownDescription: "Crew list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Person name.",
+							Computed:            true,
+						},
+						"department": schema.StringAttribute{
+							MarkdownDescription: "Department.",
+							Computed:            true,
+						},
+						"job": schema.StringAttribute{
+							MarkdownDescription: "Job.",
+							Computed:            true,
+						},
+						"person_tmdb_id": schema.Int64Attribute{
+							MarkdownDescription: "TMDB person ID.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MovieCreditsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *MovieCreditsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
+	var data *MovieCredits
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get credits current value, the client does not decode the credit list
+	httpResp, err := d.client.CreditAPI.GetCredit(ctx).MovieId(int32(data.MovieID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, movieCreditsDataSourceName, err))
+
+		return
+	}
+
+	var credits []radarr.CreditResource
+
+	err = json.NewDecoder(httpResp.Body).Decode(&credits)
+	httpResp.Body.Close()
+
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, movieCreditsDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+movieCreditsDataSourceName)
+	// Map response body to resource schema attribute
+	data.write(ctx, credits, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (m *MovieCredits) write(ctx context.Context, credits []radarr.CreditResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	cast := make([]CastCredit, 0, len(credits))
+	crew := make([]CrewCredit, 0, len(credits))
+
+	slices.SortStableFunc(credits, func(a, b radarr.CreditResource) int { return cmp.Compare(a.GetOrder(), b.GetOrder()) })
+
+	for _, c := range credits {
+		switch c.GetType() {
+		case radarr.CREDITTYPE_CAST:
+			cast = append(cast, CastCredit{
+				Name:         types.StringValue(c.GetPersonName()),
+				Character:    types.StringValue(c.GetCharacter()),
+				Order:        types.Int64Value(int64(c.GetOrder())),
+				PersonTMDBID: types.Int64Value(int64(c.GetPersonTmdbId())),
+			})
+		case radarr.CREDITTYPE_CREW:
+			crew = append(crew, CrewCredit{
+				Name:         types.StringValue(c.GetPersonName()),
+				Department:   types.StringValue(c.GetDepartment()),
+				Job:          types.StringValue(c.GetJob()),
+				PersonTMDBID: types.Int64Value(int64(c.GetPersonTmdbId())),
+			})
+		}
+	}
+
+	m.ID = types.StringValue(strconv.Itoa(int(m.MovieID.ValueInt64())))
+	m.Cast, tempDiag = types.ListValueFrom(ctx, CastCredit{}.getType(), cast)
+	diags.Append(tempDiag...)
+	m.Crew, tempDiag = types.ListValueFrom(ctx, CrewCredit{}.getType(), crew)
+	diags.Append(tempDiag...)
+}
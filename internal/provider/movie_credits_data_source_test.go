@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMovieCreditsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccMovieResourceConfig("Error", "error", 0) + testAccMovieCreditsDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccMovieResourceConfig("Jurassic Park", "Jurassic_Park_1993", 329) + testAccMovieCreditsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.radarr_movie_credits.test", "cast.0.name", "Sam Neill"),
+					resource.TestCheckResourceAttrSet("data.radarr_movie_credits.test", "cast.0.person_tmdb_id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.radarr_movie_credits.test", "crew.*", map[string]string{"name": "Steven Spielberg", "job": "Director", "person_tmdb_id": "488"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccMovieCreditsDataSourceConfig = `
+data "radarr_movie_credits" "test" {
+	movie_id = radarr_movie.test.id
+}
+`
@@ -25,7 +25,7 @@ func NewMovieDataSource() datasource.DataSource {
 // MovieDataSource defines the movie implementation.
 type MovieDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *MovieDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -121,6 +121,176 @@ func (d *MovieDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 					},
 				},
 			},
+			"sort_title": schema.StringAttribute{
+				MarkdownDescription: "Sort title.",
+				Computed:            true,
+			},
+			"studio": schema.StringAttribute{
+				MarkdownDescription: "Studio.",
+				Computed:            true,
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Root folder path.",
+				Computed:            true,
+			},
+			"folder_name": schema.StringAttribute{
+				MarkdownDescription: "Folder name.",
+				Computed:            true,
+			},
+			"certification": schema.StringAttribute{
+				MarkdownDescription: "Certification.",
+				Computed:            true,
+			},
+			"added": schema.StringAttribute{
+				MarkdownDescription: "Date the movie was added, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"size_on_disk": schema.Int64Attribute{
+				MarkdownDescription: "Size on disk in bytes.",
+				Computed:            true,
+			},
+			"runtime": schema.Int64Attribute{
+				MarkdownDescription: "Runtime in minutes.",
+				Computed:            true,
+			},
+			"popularity": schema.Float64Attribute{
+				MarkdownDescription: "Popularity.",
+				Computed:            true,
+			},
+			"has_file": schema.BoolAttribute{
+				MarkdownDescription: "Has file flag.",
+				Computed:            true,
+			},
+			"images": schema.SetNestedAttribute{
+				MarkdownDescription: "Images.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: d.getImageSchema().Attributes,
+				},
+			},
+			"ratings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Ratings.",
+				Computed:            true,
+				Attributes:          d.getRatingsSchema().Attributes,
+			},
+			"movie_file": schema.SingleNestedAttribute{
+				MarkdownDescription: "Movie file.",
+				Computed:            true,
+				Attributes:          d.getMovieFileSchema().Attributes,
+			},
+			"collection": schema.SingleNestedAttribute{
+				MarkdownDescription: "Collection.",
+				Computed:            true,
+				Attributes:          d.getCollectionSchema().Attributes,
+			},
+		},
+	}
+}
+
+func (d MovieDataSource) getImageSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cover_type": schema.StringAttribute{
+				MarkdownDescription: "Cover type.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL.",
+				Computed:            true,
+			},
+			"remote_url": schema.StringAttribute{
+				MarkdownDescription: "Remote URL.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d MovieDataSource) getRatingsSchema() schema.Schema {
+	rating := schema.SingleNestedAttribute{
+		MarkdownDescription: "Rating.",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"value": schema.Float64Attribute{
+				MarkdownDescription: "Value.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type.",
+				Computed:            true,
+			},
+			"votes": schema.Int64Attribute{
+				MarkdownDescription: "Votes.",
+				Computed:            true,
+			},
+		},
+	}
+
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"imdb":            rating,
+			"tmdb":            rating,
+			"metacritic":      rating,
+			"rotten_tomatoes": rating,
+			"trakt":           rating,
+		},
+	}
+}
+
+func (d MovieDataSource) getMovieFileSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Movie file ID.",
+				Computed:            true,
+			},
+			"relative_path": schema.StringAttribute{
+				MarkdownDescription: "Relative path.",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Full path.",
+				Computed:            true,
+			},
+			"quality": schema.StringAttribute{
+				MarkdownDescription: "Quality name.",
+				Computed:            true,
+			},
+			"release_group": schema.StringAttribute{
+				MarkdownDescription: "Release group.",
+				Computed:            true,
+			},
+			"edition": schema.StringAttribute{
+				MarkdownDescription: "Edition.",
+				Computed:            true,
+			},
+			"date_added": schema.StringAttribute{
+				MarkdownDescription: "Date the file was added, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size in bytes.",
+				Computed:            true,
+			},
+			"quality_cutoff_not_met": schema.BoolAttribute{
+				MarkdownDescription: "Quality cutoff not met flag.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d MovieDataSource) getCollectionSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Collection title.",
+				Computed:            true,
+			},
+			"tmdb_id": schema.Int64Attribute{
+				MarkdownDescription: "Collection TMDB ID.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -133,6 +303,8 @@ func (d *MovieDataSource) Configure(ctx context.Context, req datasource.Configur
 }
 
 func (d *MovieDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	var data *Movie
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -142,7 +314,7 @@ func (d *MovieDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Get movies current value
-	response, _, err := d.client.MovieAPI.ListMovie(d.auth).Execute()
+	response, _, err := d.client.MovieAPI.ListMovie(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, movieDataSourceName, err))
 
@@ -31,6 +31,8 @@ func TestAccMovieDataSource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.radarr_movie.test", "id"),
 					resource.TestCheckResourceAttr("data.radarr_movie.test", "title", "Pulp Fiction"),
+					resource.TestCheckResourceAttr("data.radarr_movie.test", "sort_title", "pulp fiction"),
+					resource.TestCheckResourceAttrSet("data.radarr_movie.test", "runtime"),
 				),
 			},
 		},
@@ -0,0 +1,584 @@
+package provider
+
+import (
+	"context"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const movieFileResourceName = "movie_file"
+
+// movieFile3DRegexp matches the 3D markers of a release name.
+var movieFile3DRegexp = regexp.MustCompile(`(?i)\b(3D|H-?SBS|H-?OU|Half-SBS|Half-OU|MVC)\b`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &MovieFileResource{}
+	_ resource.ResourceWithImportState = &MovieFileResource{}
+)
+
+func NewMovieFileResource() resource.Resource {
+	return &MovieFileResource{}
+}
+
+// MovieFileResource defines the movie file implementation.
+type MovieFileResource struct {
+	client *radarr.APIClient
+	auth   radarrAuth
+}
+
+// MovieFile describes the movie file data model.
+type MovieFile struct {
+	Languages           types.Set    `tfsdk:"languages"`
+	CustomFormats       types.Set    `tfsdk:"custom_formats"`
+	MediaInfo           types.Object `tfsdk:"media_info"`
+	Path                types.String `tfsdk:"path"`
+	RelativePath        types.String `tfsdk:"relative_path"`
+	Quality             types.String `tfsdk:"quality"`
+	Edition             types.String `tfsdk:"edition"`
+	ReleaseGroup        types.String `tfsdk:"release_group"`
+	SceneName           types.String `tfsdk:"scene_name"`
+	DateAdded           types.String `tfsdk:"date_added"`
+	ID                  types.Int64  `tfsdk:"id"`
+	MovieID             types.Int64  `tfsdk:"movie_id"`
+	QualityID           types.Int64  `tfsdk:"quality_id"`
+	Size                types.Int64  `tfsdk:"size"`
+	CustomFormatScore   types.Int64  `tfsdk:"custom_format_score"`
+	QualityCutoffNotMet types.Bool   `tfsdk:"quality_cutoff_not_met"`
+}
+
+// MovieFileResourceModel describes the movie file resource data model.
+type MovieFileResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	MovieFile
+}
+
+// MovieMediaInfo is part of MovieFile.
+type MovieMediaInfo struct {
+	AudioLanguages        types.List    `tfsdk:"audio_languages"`
+	SubtitleLanguages     types.List    `tfsdk:"subtitle_languages"`
+	AudioChannels         types.Float64 `tfsdk:"audio_channels"`
+	VideoFPS              types.Float64 `tfsdk:"video_fps"`
+	AudioCodec            types.String  `tfsdk:"audio_codec"`
+	VideoCodec            types.String  `tfsdk:"video_codec"`
+	VideoDynamicRange     types.String  `tfsdk:"video_dynamic_range"`
+	VideoDynamicRangeType types.String  `tfsdk:"video_dynamic_range_type"`
+	Resolution            types.String  `tfsdk:"resolution"`
+	RunTime               types.String  `tfsdk:"run_time"`
+	ScanType              types.String  `tfsdk:"scan_type"`
+	AudioBitrate          types.Int64   `tfsdk:"audio_bitrate"`
+	AudioStreamCount      types.Int64   `tfsdk:"audio_stream_count"`
+	VideoBitrate          types.Int64   `tfsdk:"video_bitrate"`
+	VideoBitDepth         types.Int64   `tfsdk:"video_bit_depth"`
+	Is3D                  types.Bool    `tfsdk:"is_3d"`
+}
+
+func (f MovieFile) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"languages":              types.SetType{}.WithElementType(types.StringType),
+			"custom_formats":         types.SetType{}.WithElementType(types.StringType),
+			"media_info":             MovieMediaInfo{}.getType(),
+			"path":                   types.StringType,
+			"relative_path":          types.StringType,
+			"quality":                types.StringType,
+			"edition":                types.StringType,
+			"release_group":          types.StringType,
+			"scene_name":             types.StringType,
+			"date_added":             types.StringType,
+			"id":                     types.Int64Type,
+			"movie_id":               types.Int64Type,
+			"quality_id":             types.Int64Type,
+			"size":                   types.Int64Type,
+			"custom_format_score":    types.Int64Type,
+			"quality_cutoff_not_met": types.BoolType,
+		})
+}
+
+func (i MovieMediaInfo) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"audio_languages":          types.ListType{}.WithElementType(types.StringType),
+			"subtitle_languages":       types.ListType{}.WithElementType(types.StringType),
+			"audio_channels":           types.Float64Type,
+			"video_fps":                types.Float64Type,
+			"audio_codec":              types.StringType,
+			"video_codec":              types.StringType,
+			"video_dynamic_range":      types.StringType,
+			"video_dynamic_range_type": types.StringType,
+			"resolution":               types.StringType,
+			"run_time":                 types.StringType,
+			"scan_type":                types.StringType,
+			"audio_bitrate":            types.Int64Type,
+			"audio_stream_count":       types.Int64Type,
+			"video_bitrate":            types.Int64Type,
+			"video_bit_depth":          types.Int64Type,
+			"is_3d":                    types.BoolType,
+		})
+}
+
+func (r *MovieFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + movieFileResourceName
+}
+
+func (r *MovieFileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Movies -->\nMovie File resource.\nCorrects the quality, languages, edition and release group of an existing movie file, values not given are left as they are.\nDestroying the resource only removes it from the state, the file is kept.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Movie file ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"quality_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality ID.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"languages": schema.SetAttribute{
+				MarkdownDescription: "Language names.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"edition": schema.StringAttribute{
+				MarkdownDescription: "Edition.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"release_group": schema.StringAttribute{
+				MarkdownDescription: "Release group.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"movie_id": schema.Int64Attribute{
+				MarkdownDescription: "Movie ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Full path.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"relative_path": schema.StringAttribute{
+				MarkdownDescription: "Path relative to the movie folder.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size in bytes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"date_added": schema.StringAttribute{
+				MarkdownDescription: "Date added in RFC3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scene_name": schema.StringAttribute{
+				MarkdownDescription: "Scene name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"quality": schema.StringAttribute{
+				MarkdownDescription: "Quality name.",
+				Computed:            true,
+			},
+			"custom_formats": schema.SetAttribute{
+				MarkdownDescription: "Matching custom format names.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"custom_format_score": schema.Int64Attribute{
+				MarkdownDescription: "Custom format score.",
+				Computed:            true,
+			},
+			"quality_cutoff_not_met": schema.BoolAttribute{
+				MarkdownDescription: "Quality cutoff not met flag.",
+				Computed:            true,
+			},
+			"media_info": schema.SingleNestedAttribute{
+				MarkdownDescription: "Media info.",
+				Computed:            true,
+				Attributes:          r.getMediaInfoSchema().Attributes,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r MovieFileResource) getMediaInfoSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"audio_bitrate": schema.Int64Attribute{
+				MarkdownDescription: "Audio bitrate.",
+				Computed:            true,
+			},
+			"audio_channels": schema.Float64Attribute{
+				MarkdownDescription: "Audio channels.",
+				Computed:            true,
+			},
+			"audio_codec": schema.StringAttribute{
+				MarkdownDescription: "Audio codec.",
+				Computed:            true,
+			},
+			"audio_languages": schema.ListAttribute{
+				MarkdownDescription: "Audio language codes.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"audio_stream_count": schema.Int64Attribute{
+				MarkdownDescription: "Audio stream count.",
+				Computed:            true,
+			},
+			"video_bit_depth": schema.Int64Attribute{
+				MarkdownDescription: "Video bit depth.",
+				Computed:            true,
+			},
+			"video_bitrate": schema.Int64Attribute{
+				MarkdownDescription: "Video bitrate.",
+				Computed:            true,
+			},
+			"video_codec": schema.StringAttribute{
+				MarkdownDescription: "Video codec.",
+				Computed:            true,
+			},
+			"video_fps": schema.Float64Attribute{
+				MarkdownDescription: "Video frames per second.",
+				Computed:            true,
+			},
+			"video_dynamic_range": schema.StringAttribute{
+				MarkdownDescription: "Video dynamic range.",
+				Computed:            true,
+			},
+			"video_dynamic_range_type": schema.StringAttribute{
+				MarkdownDescription: "Video dynamic range type.",
+				Computed:            true,
+			},
+			"resolution": schema.StringAttribute{
+				MarkdownDescription: "Resolution.",
+				Computed:            true,
+			},
+			"run_time": schema.StringAttribute{
+				MarkdownDescription: "Run time.",
+				Computed:            true,
+			},
+			"scan_type": schema.StringAttribute{
+				MarkdownDescription: "Scan type.",
+				Computed:            true,
+			},
+			"subtitle_languages": schema.ListAttribute{
+				MarkdownDescription: "Subtitle language codes.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"is_3d": schema.BoolAttribute{
+				MarkdownDescription: "3D flag, detected from the scene name or the file name since Radarr does not expose the video views.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *MovieFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *MovieFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
+	// Retrieve values from plan
+	var file *MovieFileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &file)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Movie files are imported by Radarr, so creating the resource edits the existing one
+	response, err := r.edit(ctx, file, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err != nil {
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, movieFileResourceName, err)
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+movieFileResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	file.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &file)...)
+}
+
+func (r *MovieFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
+	// Get current state
+	var file *MovieFileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &file)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get movie file current value
+	response, httpResp, err := r.client.MovieFileAPI.GetMovieFileById(ctx, int32(file.ID.ValueInt64())).Execute()
+	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, movieFileResourceName, httpResp, err, resp) {
+			return
+		}
+
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, movieFileResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+movieFileResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	file.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &file)...)
+}
+
+func (r *MovieFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
+	// Get plan values
+	var file *MovieFileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &file)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update MovieFile
+	response, err := r.edit(ctx, file, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err != nil {
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, movieFileResourceName, err)
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+movieFileResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	file.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &file)...)
+}
+
+func (r *MovieFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Movie files are not deleted just removing configuration
+	tflog.Trace(ctx, "decoupled "+movieFileResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *MovieFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	tflog.Trace(ctx, "imported "+movieFileResourceName+": "+req.ID)
+}
+
+// edit sends the known values through the bulk endpoint, which replaces the deprecated movie file editor.
+func (r *MovieFileResource) edit(ctx context.Context, file *MovieFileResourceModel, diags *diag.Diagnostics) (*radarr.MovieFileResource, error) {
+	current, _, err := r.client.MovieFileAPI.GetMovieFileById(ctx, int32(file.ID.ValueInt64())).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	request := radarr.NewMovieFileResource()
+	request.SetId(current.GetId())
+
+	if !file.QualityID.IsUnknown() {
+		var definitions []radarr.QualityDefinitionResource
+
+		if definitions, _, err = r.client.QualityDefinitionAPI.ListQualityDefinition(ctx).Execute(); err != nil {
+			return nil, err
+		}
+
+		quality := current.GetQuality()
+		if quality.Quality, err = findQuality(definitions, file.QualityID.ValueInt64()); err != nil {
+			diags.AddAttributeError(path.Root("quality_id"), helpers.ValidationError, err.Error())
+
+			return nil, nil
+		}
+
+		request.SetQuality(quality)
+	}
+
+	if !file.Languages.IsUnknown() {
+		var (
+			languages []radarr.LanguageResource
+			names     []string
+		)
+
+		if languages, _, err = r.client.LanguageAPI.ListLanguage(ctx).Execute(); err != nil {
+			return nil, err
+		}
+
+		diags.Append(file.Languages.ElementsAs(ctx, &names, false)...)
+
+		if request.Languages, err = findLanguages(languages, names); err != nil {
+			diags.AddAttributeError(path.Root("languages"), helpers.ValidationError, err.Error())
+
+			return nil, nil
+		}
+	}
+
+	if !file.Edition.IsUnknown() {
+		request.SetEdition(file.Edition.ValueString())
+	}
+
+	if !file.ReleaseGroup.IsUnknown() {
+		request.SetReleaseGroup(file.ReleaseGroup.ValueString())
+	}
+
+	if _, err = r.client.MovieFileAPI.PutMovieFileBulk(ctx).MovieFileResource([]radarr.MovieFileResource{*request}).Execute(); err != nil {
+		return nil, err
+	}
+
+	response, _, err := r.client.MovieFileAPI.GetMovieFileById(ctx, current.GetId()).Execute()
+
+	return response, err
+}
+
+func (f *MovieFile) write(ctx context.Context, file *radarr.MovieFileResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	qualityModel := file.GetQuality()
+	quality := qualityModel.GetQuality()
+
+	languages := make([]string, len(file.GetLanguages()))
+	for i, l := range file.GetLanguages() {
+		languages[i] = l.GetName()
+	}
+
+	customFormats := make([]string, len(file.GetCustomFormats()))
+	for i, c := range file.GetCustomFormats() {
+		customFormats[i] = c.GetName()
+	}
+
+	f.ID = types.Int64Value(int64(file.GetId()))
+	f.MovieID = types.Int64Value(int64(file.GetMovieId()))
+	f.Path = types.StringValue(file.GetPath())
+	f.RelativePath = types.StringValue(file.GetRelativePath())
+	f.Size = types.Int64Value(file.GetSize())
+	f.DateAdded = types.StringValue(file.GetDateAdded().Format(time.RFC3339))
+	f.SceneName = types.StringValue(file.GetSceneName())
+	f.QualityID = types.Int64Value(int64(quality.GetId()))
+	f.Quality = types.StringValue(quality.GetName())
+	f.Edition = types.StringValue(file.GetEdition())
+	f.ReleaseGroup = types.StringValue(file.GetReleaseGroup())
+	f.CustomFormatScore = types.Int64Value(int64(file.GetCustomFormatScore()))
+	f.QualityCutoffNotMet = types.BoolValue(file.GetQualityCutoffNotMet())
+	f.Languages, tempDiag = types.SetValueFrom(ctx, types.StringType, languages)
+	diags.Append(tempDiag...)
+	f.CustomFormats, tempDiag = types.SetValueFrom(ctx, types.StringType, customFormats)
+	diags.Append(tempDiag...)
+	f.writeMediaInfo(ctx, file, diags)
+}
+
+func (f *MovieFile) writeMediaInfo(ctx context.Context, file *radarr.MovieFileResource, diags *diag.Diagnostics) {
+	info := file.MediaInfo
+	if info == nil {
+		assignNullObject(diags, &f.MediaInfo, "media_info", MovieMediaInfo{}.getType())
+
+		return
+	}
+
+	var tempDiag diag.Diagnostics
+
+	mediaInfo := MovieMediaInfo{
+		AudioChannels:         types.Float64Value(info.GetAudioChannels()),
+		VideoFPS:              types.Float64Value(info.GetVideoFps()),
+		AudioCodec:            types.StringValue(info.GetAudioCodec()),
+		VideoCodec:            types.StringValue(info.GetVideoCodec()),
+		VideoDynamicRange:     types.StringValue(info.GetVideoDynamicRange()),
+		VideoDynamicRangeType: types.StringValue(info.GetVideoDynamicRangeType()),
+		Resolution:            types.StringValue(info.GetResolution()),
+		RunTime:               types.StringValue(info.GetRunTime()),
+		ScanType:              types.StringValue(info.GetScanType()),
+		AudioBitrate:          types.Int64Value(info.GetAudioBitrate()),
+		AudioStreamCount:      types.Int64Value(int64(info.GetAudioStreamCount())),
+		VideoBitrate:          types.Int64Value(info.GetVideoBitrate()),
+		VideoBitDepth:         types.Int64Value(int64(info.GetVideoBitDepth())),
+		Is3D:                  types.BoolValue(movieFile3D(file)),
+	}
+
+	mediaInfo.AudioLanguages, tempDiag = types.ListValueFrom(ctx, types.StringType, mediaInfoLanguages(info.GetAudioLanguages()))
+	diags.Append(tempDiag...)
+	mediaInfo.SubtitleLanguages, tempDiag = types.ListValueFrom(ctx, types.StringType, mediaInfoLanguages(info.GetSubtitles()))
+	diags.Append(tempDiag...)
+
+	assignObjectValue(ctx, diags, &f.MediaInfo, "media_info", mediaInfo, MovieMediaInfo{}.getType())
+}
+
+// movieFile3D reports whether the file is 3D.
+// Radarr reads it from the video views, which are not part of the API, so the release name is checked instead.
+func movieFile3D(file *radarr.MovieFileResource) bool {
+	return movieFile3DRegexp.MatchString(file.GetSceneName()) || movieFile3DRegexp.MatchString(filepath.Base(file.GetRelativePath()))
+}
+
+// mediaInfoLanguages splits the language codes that Radarr joins with slashes.
+func mediaInfoLanguages(languages string) []string {
+	if languages == "" {
+		return make([]string, 0)
+	}
+
+	return strings.Split(languages, "/")
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccMovieFileResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccMovieFileResourceConfig("GROUP") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Missing file
+			{
+				Config:      testAccMovieFileResourceConfig("GROUP"),
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+		},
+	})
+}
+
+func testAccMovieFileResourceConfig(group string) string {
+	return fmt.Sprintf(`
+	resource "radarr_movie_file" "test" {
+		id = 999
+		quality_id = 7
+		languages = ["English"]
+		edition = "Director's Cut"
+		release_group = "%s"
+	}`, group)
+}
+
+func TestMovieFileResourceEdit(t *testing.T) {
+	t.Parallel()
+
+	var edited []map[string]interface{}
+
+	file := map[string]interface{}{
+		"id":           5,
+		"movieId":      11,
+		"path":         "/movies/Star Wars (1977)/Star.Wars.1977.mkv",
+		"quality":      map[string]interface{}{"quality": map[string]interface{}{"id": 3, "name": "WEBDL-1080p"}},
+		"languages":    []map[string]interface{}{{"id": 1, "name": "English"}},
+		"edition":      "",
+		"releaseGroup": "GROUP",
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v3/moviefile/5", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(file)
+	})
+	mux.HandleFunc("GET /api/v3/qualitydefinition", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":1,"quality":{"id":3,"name":"WEBDL-1080p"}},{"id":2,"quality":{"id":7,"name":"Bluray-1080p"}}]`))
+	})
+	mux.HandleFunc("GET /api/v3/language", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":1,"name":"English"},{"id":4,"name":"German"}]`))
+	})
+	mux.HandleFunc("PUT /api/v3/moviefile/bulk", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&edited))
+		// Radarr applies the sent values to the movie file.
+		for key, value := range edited[0] {
+			file[key] = value
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	ctx := context.WithValue(context.Background(), radarr.ContextServerVariables, map[string]string{
+		"protocol": serverURL.Scheme,
+		"hostpath": serverURL.Host,
+	})
+	r := &MovieFileResource{client: radarr.NewAPIClient(radarr.NewConfiguration())}
+
+	var diags diag.Diagnostics
+
+	model := &MovieFileResourceModel{MovieFile: MovieFile{
+		ID:           types.Int64Value(5),
+		QualityID:    types.Int64Value(7),
+		Languages:    types.SetValueMust(types.StringType, []attr.Value{types.StringValue("german")}),
+		Edition:      types.StringValue("Director's Cut"),
+		ReleaseGroup: types.StringUnknown(),
+	}}
+
+	response, err := r.edit(ctx, model, &diags)
+	assert.NoError(t, err)
+	assert.False(t, diags.HasError())
+
+	// Only the known values are sent.
+	assert.Len(t, edited, 1)
+	assert.NotContains(t, edited[0], "releaseGroup")
+	assert.Equal(t, map[string]interface{}{"id": float64(7), "name": "Bluray-1080p"}, edited[0]["quality"].(map[string]interface{})["quality"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"id": float64(4), "name": "German"}}, edited[0]["languages"])
+
+	model.write(ctx, response, &diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, int64(7), model.QualityID.ValueInt64())
+	assert.Equal(t, "Bluray-1080p", model.Quality.ValueString())
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("German")}), model.Languages)
+	assert.Equal(t, "Director's Cut", model.Edition.ValueString())
+	assert.Equal(t, "GROUP", model.ReleaseGroup.ValueString())
+	assert.Equal(t, int64(11), model.MovieID.ValueInt64())
+
+	// Unknown qualities are reported on the attribute.
+	model.QualityID = types.Int64Value(99)
+	response, err = r.edit(ctx, model, &diags)
+	assert.NoError(t, err)
+	assert.Nil(t, response)
+	assert.True(t, diags.HasError())
+}
+
+func TestMovieFileRenderMovieName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		file     radarr.MovieFileResource
+		expected string
+	}{
+		"media info": {
+			file: radarr.MovieFileResource{
+				RelativePath: *radarr.NewNullableString(radarr.PtrString("Avatar.2009.1080p.BluRay.3D.HSBS.x264-GROUP.mkv")),
+				ReleaseGroup: *radarr.NewNullableString(radarr.PtrString("GROUP")),
+				MediaInfo: &radarr.MediaInfoResource{
+					VideoCodec: *radarr.NewNullableString(radarr.PtrString("x264")),
+					Subtitles:  *radarr.NewNullableString(radarr.PtrString("eng/ger")),
+				},
+			},
+			expected: "Avatar (2009) [3D][EN+DE][x264]-GROUP",
+		},
+		"2D": {
+			file: radarr.MovieFileResource{
+				SceneName: *radarr.NewNullableString(radarr.PtrString("Avatar.2009.1080p.BluRay.x264-GROUP")),
+				MediaInfo: &radarr.MediaInfoResource{
+					VideoCodec: *radarr.NewNullableString(radarr.PtrString("x264")),
+				},
+			},
+			expected: "Avatar (2009) [x264]",
+		},
+		"without media info": {
+			file:     radarr.MovieFileResource{},
+			expected: "Avatar (2009)",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			var (
+				file  MovieFile
+				diags diag.Diagnostics
+			)
+
+			file.write(ctx, &test.file, &diags)
+			assert.False(t, diags.HasError())
+
+			// the movie file object is passed as is to the render function.
+			fileObject, diags := types.ObjectValueFrom(ctx, file.getType().(types.ObjectType).AttrTypes, file)
+			assert.False(t, diags.HasError())
+
+			reader := namingMovieReader{}
+			reader.read(types.ObjectValueMust(
+				map[string]attr.Type{"title": types.StringType, "year": types.Int64Type, "movie_file": fileObject.Type(ctx)},
+				map[string]attr.Value{"title": types.StringValue("Avatar"), "year": types.Int64Value(2009), "movie_file": fileObject},
+			))
+			assert.False(t, reader.unknown)
+
+			result, err := helpers.RenderMovieName("{Movie CleanTitle} ({Release Year}) {[MediaInfo 3D]}{MediaInfo SubtitleLanguages}{[MediaInfo VideoCodec]}{-Release Group}", &reader.movie)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
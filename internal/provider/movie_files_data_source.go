@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const movieFilesDataSourceName = "movie_files"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MovieFilesDataSource{}
+
+func NewMovieFilesDataSource() datasource.DataSource {
+	return &MovieFilesDataSource{}
+}
+
+// MovieFilesDataSource defines the movie files implementation.
+type MovieFilesDataSource struct {
+	client *radarr.APIClient
+	auth   radarrAuth
+}
+
+// MovieFiles describes the movie files data model.
+type MovieFiles struct {
+	Files   types.List   `tfsdk:"files"`
+	ID      types.String `tfsdk:"id"`
+	MovieID types.Int64  `tfsdk:"movie_id"`
+}
+
+func (d *MovieFilesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + movieFilesDataSourceName
+}
+
+func (d *MovieFilesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Movies -->\nList all [Movie Files](../resources/movie_file) of a movie.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"movie_id": schema.Int64Attribute{
+				MarkdownDescription: "Movie ID.",
+				Required:            true,
+			},
+			"files": schema.ListNestedAttribute{
+				MarkdownDescription: "Movie file list, sorted by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Movie file ID.",
+							Computed:            true,
+						},
+						"movie_id": schema.Int64Attribute{
+							MarkdownDescription: "Movie ID.",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Full path.",
+							Computed:            true,
+						},
+						"relative_path": schema.StringAttribute{
+							MarkdownDescription: "Path relative to the movie folder.",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Size in bytes.",
+							Computed:            true,
+						},
+						"date_added": schema.StringAttribute{
+							MarkdownDescription: "Date added in RFC3339 format.",
+							Computed:            true,
+						},
+						"scene_name": schema.StringAttribute{
+							MarkdownDescription: "Scene name.",
+							Computed:            true,
+						},
+						"quality_id": schema.Int64Attribute{
+							MarkdownDescription: "Quality ID.",
+							Computed:            true,
+						},
+						"quality": schema.StringAttribute{
+							MarkdownDescription: "Quality name.",
+							Computed:            true,
+						},
+						"languages": schema.SetAttribute{
+							MarkdownDescription: "Language names.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"edition": schema.StringAttribute{
+							MarkdownDescription: "Edition.",
+							Computed:            true,
+						},
+						"release_group": schema.StringAttribute{
+							MarkdownDescription: "Release group.",
+							Computed:            true,
+						},
+						"custom_formats": schema.SetAttribute{
+							MarkdownDescription: "Matching custom format names.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"custom_format_score": schema.Int64Attribute{
+							MarkdownDescription: "Custom format score.",
+							Computed:            true,
+						},
+						"quality_cutoff_not_met": schema.BoolAttribute{
+							MarkdownDescription: "Quality cutoff not met flag.",
+							Computed:            true,
+						},
+						"media_info": schema.SingleNestedAttribute{
+							MarkdownDescription: "Media info.",
+							Computed:            true,
+							Attributes:          d.getMediaInfoSchema().Attributes,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d MovieFilesDataSource) getMediaInfoSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"audio_bitrate": schema.Int64Attribute{
+				MarkdownDescription: "Audio bitrate.",
+				Computed:            true,
+			},
+			"audio_channels": schema.Float64Attribute{
+				MarkdownDescription: "Audio channels.",
+				Computed:            true,
+			},
+			"audio_codec": schema.StringAttribute{
+				MarkdownDescription: "Audio codec.",
+				Computed:            true,
+			},
+			"audio_languages": schema.ListAttribute{
+				MarkdownDescription: "Audio language codes.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"audio_stream_count": schema.Int64Attribute{
+				MarkdownDescription: "Audio stream count.",
+				Computed:            true,
+			},
+			"video_bit_depth": schema.Int64Attribute{
+				MarkdownDescription: "Video bit depth.",
+				Computed:            true,
+			},
+			"video_bitrate": schema.Int64Attribute{
+				MarkdownDescription: "Video bitrate.",
+				Computed:            true,
+			},
+			"video_codec": schema.StringAttribute{
+				MarkdownDescription: "Video codec.",
+				Computed:            true,
+			},
+			"video_fps": schema.Float64Attribute{
+				MarkdownDescription: "Video frames per second.",
+				Computed:            true,
+			},
+			"video_dynamic_range": schema.StringAttribute{
+				MarkdownDescription: "Video dynamic range.",
+				Computed:            true,
+			},
+			"video_dynamic_range_type": schema.StringAttribute{
+				MarkdownDescription: "Video dynamic range type.",
+				Computed:            true,
+			},
+			"resolution": schema.StringAttribute{
+				MarkdownDescription: "Resolution.",
+				Computed:            true,
+			},
+			"run_time": schema.StringAttribute{
+				MarkdownDescription: "Run time.",
+				Computed:            true,
+			},
+			"scan_type": schema.StringAttribute{
+				MarkdownDescription: "Scan type.",
+				Computed:            true,
+			},
+			"subtitle_languages": schema.ListAttribute{
+				MarkdownDescription: "Subtitle language codes.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"is_3d": schema.BoolAttribute{
+				MarkdownDescription: "3D flag, detected from the scene name or the file name since Radarr does not expose the video views.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *MovieFilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *MovieFilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
+	var data *MovieFiles
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get movie files current value
+	response, _, err := d.client.MovieFileAPI.ListMovieFile(ctx).MovieId([]int32{int32(data.MovieID.ValueInt64())}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, movieFilesDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+movieFilesDataSourceName)
+	// Map response body to resource schema attribute
+	data.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (m *MovieFiles) write(ctx context.Context, files []radarr.MovieFileResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	slices.SortStableFunc(files, func(a, b radarr.MovieFileResource) int {
+		return cmp.Compare(a.GetId(), b.GetId())
+	})
+
+	list := make([]MovieFile, len(files))
+	for i, f := range files {
+		list[i].write(ctx, &f, diags)
+	}
+
+	m.ID = types.StringValue(strconv.Itoa(len(files)))
+	m.Files, tempDiag = types.ListValueFrom(ctx, MovieFile{}.getType(), list)
+	diags.Append(tempDiag...)
+}
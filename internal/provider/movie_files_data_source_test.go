@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMovieFilesDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccMovieResourceConfig("Error", "error", 0) + testAccMovieFilesDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccMovieResourceConfig("Kill Bill: Vol. 1", "Kill_Bill_Vol_1_2003", 24) + testAccMovieFilesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.radarr_movie_files.test", "id"),
+					resource.TestCheckResourceAttr("data.radarr_movie_files.test", "files.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMovieFilesDataSourceConfig = `
+data "radarr_movie_files" "test" {
+	movie_id = radarr_movie.test.id
+}
+`
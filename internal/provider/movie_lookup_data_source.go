@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const movieLookupDataSourceName = "movie_lookup"
+
+// imdbIDRegexp matches a plain IMDb ID.
+var imdbIDRegexp = regexp.MustCompile(`^tt\d+$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MovieLookupDataSource{}
+
+func NewMovieLookupDataSource() datasource.DataSource {
+	return &MovieLookupDataSource{}
+}
+
+// MovieLookupDataSource defines the movie lookup implementation.
+type MovieLookupDataSource struct {
+	client *radarr.APIClient
+	auth   radarrAuth
+}
+
+// MovieLookup describes the movie lookup data model.
+type MovieLookup struct {
+	Movies types.List   `tfsdk:"movies"`
+	Term   types.String `tfsdk:"term"`
+	ID     types.String `tfsdk:"id"`
+}
+
+// MovieLookupResult is part of MovieLookup.
+type MovieLookupResult struct {
+	OriginalLanguage types.Object `tfsdk:"original_language"`
+	Title            types.String `tfsdk:"title"`
+	OriginalTitle    types.String `tfsdk:"original_title"`
+	IMDBID           types.String `tfsdk:"imdb_id"`
+	Folder           types.String `tfsdk:"folder"`
+	Overview         types.String `tfsdk:"overview"`
+	Status           types.String `tfsdk:"status"`
+	ID               types.Int64  `tfsdk:"id"`
+	TMDBID           types.Int64  `tfsdk:"tmdb_id"`
+	Year             types.Int64  `tfsdk:"year"`
+	Runtime          types.Int64  `tfsdk:"runtime"`
+}
+
+func (r MovieLookupResult) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"original_language": QualityLanguage{}.getType(),
+			"title":             types.StringType,
+			"original_title":    types.StringType,
+			"imdb_id":           types.StringType,
+			"folder":            types.StringType,
+			"overview":          types.StringType,
+			"status":            types.StringType,
+			"id":                types.Int64Type,
+			"tmdb_id":           types.Int64Type,
+			"year":              types.Int64Type,
+			"runtime":           types.Int64Type,
+		})
+}
+
+func (d *MovieLookupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + movieLookupDataSourceName
+}
+
+func (d *MovieLookupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Movies -->\nSearch movies to be added as [Movie](../resources/movie).",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"term": schema.StringAttribute{
+				MarkdownDescription: "Search term. It can be a free text, a `tmdb:` or `imdb:` prefixed ID or a plain IMDb ID.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"movies": schema.ListNestedAttribute{
+				MarkdownDescription: "Movie list, ordered by relevance.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Movie ID if the movie is already in Radarr, 0 otherwise.",
+							Computed:            true,
+						},
+						"tmdb_id": schema.Int64Attribute{
+							MarkdownDescription: "TMDB ID.",
+							Computed:            true,
+						},
+						"year": schema.Int64Attribute{
+							MarkdownDescription: "Year.",
+							Computed:            true,
+						},
+						"runtime": schema.Int64Attribute{
+							MarkdownDescription: "Runtime in minutes.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Movie title.",
+							Computed:            true,
+						},
+						"original_title": schema.StringAttribute{
+							MarkdownDescription: "Movie original title.",
+							Computed:            true,
+						},
+						"imdb_id": schema.StringAttribute{
+							MarkdownDescription: "IMDB ID.",
+							Computed:            true,
+						},
+						"folder": schema.StringAttribute{
+							MarkdownDescription: "Suggested folder name, built from the naming movie folder format.",
+							Computed:            true,
+						},
+						"overview": schema.StringAttribute{
+							MarkdownDescription: "Overview.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Movie status.",
+							Computed:            true,
+						},
+						"original_language": schema.SingleNestedAttribute{
+							MarkdownDescription: "Original language.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"id": schema.Int64Attribute{
+									MarkdownDescription: "ID.",
+									Computed:            true,
+								},
+								"name": schema.StringAttribute{
+									MarkdownDescription: "Name.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MovieLookupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *MovieLookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
+	var data *MovieLookup
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Search movies
+	response, _, err := d.client.MovieLookupAPI.ListMovieLookup(ctx).Term(movieLookupTerm(data.Term.ValueString())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, movieLookupDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+movieLookupDataSourceName)
+	// Map response body to resource schema attribute
+	data.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (l *MovieLookup) write(ctx context.Context, movies []radarr.MovieResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	results := make([]MovieLookupResult, len(movies))
+	for i, m := range movies {
+		results[i].write(ctx, &m, diags)
+	}
+
+	l.ID = l.Term
+	l.Movies, tempDiag = types.ListValueFrom(ctx, MovieLookupResult{}.getType(), results)
+	diags.Append(tempDiag...)
+}
+
+func (r *MovieLookupResult) write(ctx context.Context, movie *radarr.MovieResource, diags *diag.Diagnostics) {
+	r.ID = types.Int64Value(int64(movie.GetId()))
+	r.TMDBID = types.Int64Value(int64(movie.GetTmdbId()))
+	r.Year = types.Int64Value(int64(movie.GetYear()))
+	r.Runtime = types.Int64Value(int64(movie.GetRuntime()))
+	r.Title = types.StringValue(movie.GetTitle())
+	r.OriginalTitle = types.StringValue(movie.GetOriginalTitle())
+	r.IMDBID = types.StringValue(movie.GetImdbId())
+	r.Folder = types.StringValue(movie.GetFolder())
+	r.Overview = types.StringValue(movie.GetOverview())
+	r.Status = types.StringValue(string(movie.GetStatus()))
+	language := QualityLanguage{}
+	language.write(movie.OriginalLanguage)
+	assignObjectValue(ctx, diags, &r.OriginalLanguage, "language", language, QualityLanguage{}.getType())
+}
+
+// movieLookupTerm normalizes the lookup term, plain IMDb IDs are searched as `imdb:` IDs.
+func movieLookupTerm(term string) string {
+	term = strings.TrimSpace(term)
+	if imdbIDRegexp.MatchString(term) {
+		return "imdb:" + term
+	}
+
+	return term
+}
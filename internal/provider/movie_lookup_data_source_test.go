@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMovieLookupDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccMovieLookupDataSourceConfig("The Matrix") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read by term testing
+			{
+				Config: testAccMovieLookupDataSourceConfig("The Matrix"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.radarr_movie_lookup.test", "movies.0.tmdb_id"),
+					resource.TestCheckResourceAttrSet("data.radarr_movie_lookup.test", "movies.0.title"),
+				),
+			},
+			// Read by TMDB ID testing
+			{
+				Config: testAccMovieLookupDataSourceConfig("tmdb:603"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.radarr_movie_lookup.test", "movies.0.title", "The Matrix"),
+					resource.TestCheckResourceAttr("data.radarr_movie_lookup.test", "movies.0.year", "1999"),
+					resource.TestCheckResourceAttr("data.radarr_movie_lookup.test", "movies.0.imdb_id", "tt0133093"),
+					resource.TestCheckResourceAttr("data.radarr_movie_lookup.test", "movies.0.folder", "The Matrix (1999)"),
+					resource.TestCheckResourceAttr("data.radarr_movie_lookup.test", "movies.0.original_language.name", "English"),
+				),
+			},
+			// Read by IMDb ID testing
+			{
+				Config: testAccMovieLookupDataSourceConfig("tt0133093"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.radarr_movie_lookup.test", "movies.0.tmdb_id", "603"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMovieLookupDataSourceConfig(term string) string {
+	return fmt.Sprintf(`
+	data "radarr_movie_lookup" "test" {
+		term = "%s"
+	}
+	`, term)
+}
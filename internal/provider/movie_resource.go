@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/devopsarr/radarr-go/radarr"
 	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
@@ -13,10 +15,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -26,6 +32,7 @@ const movieResourceName = "movie"
 var (
 	_ resource.Resource                = &MovieResource{}
 	_ resource.ResourceWithImportState = &MovieResource{}
+	_ resource.ResourceWithModifyPlan  = &MovieResource{}
 )
 
 func NewMovieResource() resource.Resource {
@@ -35,49 +42,161 @@ func NewMovieResource() resource.Resource {
 // MovieResource defines the movie implementation.
 type MovieResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // Movie describes the movie data model.
 type Movie struct {
-	Genres              types.Set    `tfsdk:"genres"`
-	Tags                types.Set    `tfsdk:"tags"`
-	OriginalLanguage    types.Object `tfsdk:"original_language"`
-	Title               types.String `tfsdk:"title"`
-	Path                types.String `tfsdk:"path"`
-	MinimumAvailability types.String `tfsdk:"minimum_availability"`
-	OriginalTitle       types.String `tfsdk:"original_title"`
-	Status              types.String `tfsdk:"status"`
-	IMDBID              types.String `tfsdk:"imdb_id"`
-	YouTubeTrailerID    types.String `tfsdk:"youtube_trailer_id"`
-	Overview            types.String `tfsdk:"overview"`
-	Website             types.String `tfsdk:"website"`
-	ID                  types.Int64  `tfsdk:"id"`
-	QualityProfileID    types.Int64  `tfsdk:"quality_profile_id"`
-	TMDBID              types.Int64  `tfsdk:"tmdb_id"`
-	Year                types.Int64  `tfsdk:"year"`
-	IsAvailable         types.Bool   `tfsdk:"is_available"`
-	Monitored           types.Bool   `tfsdk:"monitored"`
+	Genres              types.Set     `tfsdk:"genres"`
+	Tags                types.Set     `tfsdk:"tags"`
+	Images              types.Set     `tfsdk:"images"`
+	OriginalLanguage    types.Object  `tfsdk:"original_language"`
+	Ratings             types.Object  `tfsdk:"ratings"`
+	MovieFile           types.Object  `tfsdk:"movie_file"`
+	Collection          types.Object  `tfsdk:"collection"`
+	Popularity          types.Float64 `tfsdk:"popularity"`
+	Title               types.String  `tfsdk:"title"`
+	Path                types.String  `tfsdk:"path"`
+	MinimumAvailability types.String  `tfsdk:"minimum_availability"`
+	OriginalTitle       types.String  `tfsdk:"original_title"`
+	Status              types.String  `tfsdk:"status"`
+	IMDBID              types.String  `tfsdk:"imdb_id"`
+	YouTubeTrailerID    types.String  `tfsdk:"youtube_trailer_id"`
+	Overview            types.String  `tfsdk:"overview"`
+	Website             types.String  `tfsdk:"website"`
+	SortTitle           types.String  `tfsdk:"sort_title"`
+	Studio              types.String  `tfsdk:"studio"`
+	RootFolderPath      types.String  `tfsdk:"root_folder_path"`
+	FolderName          types.String  `tfsdk:"folder_name"`
+	Certification       types.String  `tfsdk:"certification"`
+	Added               types.String  `tfsdk:"added"`
+	ID                  types.Int64   `tfsdk:"id"`
+	QualityProfileID    types.Int64   `tfsdk:"quality_profile_id"`
+	TMDBID              types.Int64   `tfsdk:"tmdb_id"`
+	Year                types.Int64   `tfsdk:"year"`
+	SizeOnDisk          types.Int64   `tfsdk:"size_on_disk"`
+	Runtime             types.Int64   `tfsdk:"runtime"`
+	IsAvailable         types.Bool    `tfsdk:"is_available"`
+	Monitored           types.Bool    `tfsdk:"monitored"`
+	HasFile             types.Bool    `tfsdk:"has_file"`
 
 	// TODO: future Implementation
-	// SortTitle      types.String  `tfsdk:"sortTitle"`
-	// SizeOnDisk     types.Int64   `tfsdk:"sizeOnDisk"`
 	// RemotePoster   types.String  `tfsdk:"remotePoster"`
-	// HasFile        types.Bool    `tfsdk:"hasFile"`
-	// Studio         types.String  `tfsdk:"studio"`
-	// RootFolderPath types.String  `tfsdk:"root_folder_path"`
-	// FolderName     types.String  `tfsdk:"folderName"`
-	// Runtime        types.Int64   `tfsdk:"runtime"`
 	// CleanTitle     types.String  `tfsdk:"cleanTitle"`
 	// TitleSlug      types.String  `tfsdk:"titleSlug"`
 	// Folder         types.String  `tfsdk:"folder"`
-	// Certification  types.String  `tfsdk:"certification"`
-	// Added          types.String  `tfsdk:"added"`
-	// Popularity     types.Float64 `tfsdk:"popularity"`
-	// Images         types.Set     `tfsdk:"images"`
-	// Ratings        types.Object  `tfsdk:"ratings"`
-	// MovieFile      types.Object  `tfsdk:"movieFile"`
-	// Collection     types.Object  `tfsdk:"collection"`
+}
+
+// MovieImage is part of Movie.
+type MovieImage struct {
+	CoverType types.String `tfsdk:"cover_type"`
+	URL       types.String `tfsdk:"url"`
+	RemoteURL types.String `tfsdk:"remote_url"`
+}
+
+func (i MovieImage) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"cover_type": types.StringType,
+			"url":        types.StringType,
+			"remote_url": types.StringType,
+		})
+}
+
+// MovieRatings is part of Movie.
+type MovieRatings struct {
+	IMDB           types.Object `tfsdk:"imdb"`
+	TMDB           types.Object `tfsdk:"tmdb"`
+	Metacritic     types.Object `tfsdk:"metacritic"`
+	RottenTomatoes types.Object `tfsdk:"rotten_tomatoes"`
+	Trakt          types.Object `tfsdk:"trakt"`
+}
+
+func (r MovieRatings) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"imdb":            MovieRating{}.getType(),
+			"tmdb":            MovieRating{}.getType(),
+			"metacritic":      MovieRating{}.getType(),
+			"rotten_tomatoes": MovieRating{}.getType(),
+			"trakt":           MovieRating{}.getType(),
+		})
+}
+
+// MovieRating is part of MovieRatings.
+type MovieRating struct {
+	Value types.Float64 `tfsdk:"value"`
+	Type  types.String  `tfsdk:"type"`
+	Votes types.Int64   `tfsdk:"votes"`
+}
+
+func (r MovieRating) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"type":  types.StringType,
+			"votes": types.Int64Type,
+			"value": types.Float64Type,
+		})
+}
+
+// MovieFileInfo is part of Movie.
+type MovieFileInfo struct {
+	RelativePath        types.String `tfsdk:"relative_path"`
+	Path                types.String `tfsdk:"path"`
+	Quality             types.String `tfsdk:"quality"`
+	ReleaseGroup        types.String `tfsdk:"release_group"`
+	Edition             types.String `tfsdk:"edition"`
+	DateAdded           types.String `tfsdk:"date_added"`
+	ID                  types.Int64  `tfsdk:"id"`
+	Size                types.Int64  `tfsdk:"size"`
+	QualityCutoffNotMet types.Bool   `tfsdk:"quality_cutoff_not_met"`
+}
+
+func (f MovieFileInfo) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"relative_path":          types.StringType,
+			"path":                   types.StringType,
+			"quality":                types.StringType,
+			"release_group":          types.StringType,
+			"edition":                types.StringType,
+			"date_added":             types.StringType,
+			"id":                     types.Int64Type,
+			"size":                   types.Int64Type,
+			"quality_cutoff_not_met": types.BoolType,
+		})
+}
+
+// MovieCollection is part of Movie.
+type MovieCollection struct {
+	Title  types.String `tfsdk:"title"`
+	TMDBID types.Int64  `tfsdk:"tmdb_id"`
+}
+
+func (c MovieCollection) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"title":   types.StringType,
+			"tmdb_id": types.Int64Type,
+		})
+}
+
+// MovieResourceModel describes the movie resource data model.
+// It extends Movie with the attributes only used when managing a movie.
+type MovieResourceModel struct {
+	AddOptions types.Object `tfsdk:"add_options"`
+	Timeouts   types.Object `tfsdk:"timeouts"`
+	Movie
+	DeleteFiles        types.Bool `tfsdk:"delete_files"`
+	AddImportExclusion types.Bool `tfsdk:"add_import_exclusion"`
+	MoveFiles          types.Bool `tfsdk:"move_files"`
+}
+
+// AddMovieOptions is part of MovieResourceModel.
+type AddMovieOptions struct {
+	Monitor        types.String `tfsdk:"monitor"`
+	AddMethod      types.String `tfsdk:"add_method"`
+	SearchForMovie types.Bool   `tfsdk:"search_for_movie"`
 }
 
 func (m Movie) getType() attr.Type {
@@ -85,7 +204,11 @@ func (m Movie) getType() attr.Type {
 		map[string]attr.Type{
 			"genres":               types.SetType{}.WithElementType(types.StringType),
 			"tags":                 types.SetType{}.WithElementType(types.Int64Type),
+			"images":               types.SetType{}.WithElementType(MovieImage{}.getType()),
 			"original_language":    QualityLanguage{}.getType(),
+			"ratings":              MovieRatings{}.getType(),
+			"movie_file":           MovieFileInfo{}.getType(),
+			"collection":           MovieCollection{}.getType(),
 			"title":                types.StringType,
 			"path":                 types.StringType,
 			"minimum_availability": types.StringType,
@@ -95,12 +218,22 @@ func (m Movie) getType() attr.Type {
 			"youtube_trailer_id":   types.StringType,
 			"overview":             types.StringType,
 			"website":              types.StringType,
+			"sort_title":           types.StringType,
+			"studio":               types.StringType,
+			"root_folder_path":     types.StringType,
+			"folder_name":          types.StringType,
+			"certification":        types.StringType,
+			"added":                types.StringType,
 			"id":                   types.Int64Type,
 			"quality_profile_id":   types.Int64Type,
 			"tmdb_id":              types.Int64Type,
 			"year":                 types.Int64Type,
+			"size_on_disk":         types.Int64Type,
+			"runtime":              types.Int64Type,
+			"popularity":           types.Float64Type,
 			"is_available":         types.BoolType,
 			"monitored":            types.BoolType,
+			"has_file":             types.BoolType,
 		})
 }
 
@@ -112,6 +245,7 @@ func (r *MovieResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Movies -->\nMovie resource.\nFor more information refer to [Movies](https://wiki.servarr.com/radarr/library#movies) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"monitored": schema.BoolAttribute{
 				MarkdownDescription: "Monitored flag.",
 				Required:            true,
@@ -144,8 +278,15 @@ func (r *MovieResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:            true,
 			},
 			"path": schema.StringAttribute{
-				MarkdownDescription: "Full movie path.",
-				Required:            true,
+				MarkdownDescription: "Full movie path. If omitted, Radarr builds it inside `root_folder_path` using the `movie_folder_format` naming setting.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("root_folder_path")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"minimum_availability": schema.StringAttribute{
 				MarkdownDescription: "Minimum availability.\nAllowed values: 'tba', 'announced', 'inCinemas', 'released', 'deleted'.",
@@ -195,6 +336,228 @@ func (r *MovieResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:            true,
 				Attributes:          QualityProfileResource{}.getQualityLanguageSchema().Attributes,
 			},
+			"delete_files": schema.BoolAttribute{
+				MarkdownDescription: "Delete the movie files from disk when the movie is destroyed. The value is stored in state, so it must be applied before destroying the movie.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"add_import_exclusion": schema.BoolAttribute{
+				MarkdownDescription: "Add an import list exclusion when the movie is destroyed, so that import lists do not add it again. The value is stored in state, so it must be applied before destroying the movie.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"move_files": schema.BoolAttribute{
+				MarkdownDescription: "Move the existing movie files when `path` or `root_folder_path` changes.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"sort_title": schema.StringAttribute{
+				MarkdownDescription: "Sort title.",
+				Computed:            true,
+			},
+			"studio": schema.StringAttribute{
+				MarkdownDescription: "Studio.",
+				Computed:            true,
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Root folder path. When `path` is set, it must be inside this root folder. Changing it without setting `path` moves the movie folder to the new root folder.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"folder_name": schema.StringAttribute{
+				MarkdownDescription: "Folder name.",
+				Computed:            true,
+			},
+			"certification": schema.StringAttribute{
+				MarkdownDescription: "Certification.",
+				Computed:            true,
+			},
+			"added": schema.StringAttribute{
+				MarkdownDescription: "Date the movie was added, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"size_on_disk": schema.Int64Attribute{
+				MarkdownDescription: "Size on disk in bytes.",
+				Computed:            true,
+			},
+			"runtime": schema.Int64Attribute{
+				MarkdownDescription: "Runtime in minutes.",
+				Computed:            true,
+			},
+			"popularity": schema.Float64Attribute{
+				MarkdownDescription: "Popularity.",
+				Computed:            true,
+			},
+			"has_file": schema.BoolAttribute{
+				MarkdownDescription: "Has file flag.",
+				Computed:            true,
+			},
+			"images": schema.SetNestedAttribute{
+				MarkdownDescription: "Images.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: r.getImageSchema().Attributes,
+				},
+			},
+			"ratings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Ratings.",
+				Computed:            true,
+				Attributes:          r.getRatingsSchema().Attributes,
+			},
+			"movie_file": schema.SingleNestedAttribute{
+				MarkdownDescription: "Movie file.",
+				Computed:            true,
+				Attributes:          r.getMovieFileSchema().Attributes,
+			},
+			"collection": schema.SingleNestedAttribute{
+				MarkdownDescription: "Collection.",
+				Computed:            true,
+				Attributes:          r.getCollectionSchema().Attributes,
+			},
+			"add_options": schema.SingleNestedAttribute{
+				MarkdownDescription: "Add movie options. Only used when the movie is added to Radarr, changing them has no effect on an existing movie.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"monitor": schema.StringAttribute{
+						MarkdownDescription: "Monitor type.\nAllowed values: 'movieOnly', 'movieAndCollection', 'none'.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(string(radarr.MONITORTYPES_MOVIE_ONLY)),
+						Validators: []validator.String{
+							stringvalidator.OneOf(string(radarr.MONITORTYPES_MOVIE_ONLY), string(radarr.MONITORTYPES_MOVIE_AND_COLLECTION), string(radarr.MONITORTYPES_NONE)),
+						},
+					},
+					"add_method": schema.StringAttribute{
+						MarkdownDescription: "Add method.\nAllowed values: 'manual', 'list', 'collection'.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(string(radarr.ADDMOVIEMETHOD_MANUAL)),
+						Validators: []validator.String{
+							stringvalidator.OneOf(string(radarr.ADDMOVIEMETHOD_MANUAL), string(radarr.ADDMOVIEMETHOD_LIST), string(radarr.ADDMOVIEMETHOD_COLLECTION)),
+						},
+					},
+					"search_for_movie": schema.BoolAttribute{
+						MarkdownDescription: "Search for movie flag.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r MovieResource) getImageSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cover_type": schema.StringAttribute{
+				MarkdownDescription: "Cover type.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL.",
+				Computed:            true,
+			},
+			"remote_url": schema.StringAttribute{
+				MarkdownDescription: "Remote URL.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r MovieResource) getRatingsSchema() schema.Schema {
+	rating := schema.SingleNestedAttribute{
+		MarkdownDescription: "Rating.",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"value": schema.Float64Attribute{
+				MarkdownDescription: "Value.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type.",
+				Computed:            true,
+			},
+			"votes": schema.Int64Attribute{
+				MarkdownDescription: "Votes.",
+				Computed:            true,
+			},
+		},
+	}
+
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"imdb":            rating,
+			"tmdb":            rating,
+			"metacritic":      rating,
+			"rotten_tomatoes": rating,
+			"trakt":           rating,
+		},
+	}
+}
+
+func (r MovieResource) getMovieFileSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Movie file ID.",
+				Computed:            true,
+			},
+			"relative_path": schema.StringAttribute{
+				MarkdownDescription: "Relative path.",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Full path.",
+				Computed:            true,
+			},
+			"quality": schema.StringAttribute{
+				MarkdownDescription: "Quality name.",
+				Computed:            true,
+			},
+			"release_group": schema.StringAttribute{
+				MarkdownDescription: "Release group.",
+				Computed:            true,
+			},
+			"edition": schema.StringAttribute{
+				MarkdownDescription: "Edition.",
+				Computed:            true,
+			},
+			"date_added": schema.StringAttribute{
+				MarkdownDescription: "Date the file was added, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size in bytes.",
+				Computed:            true,
+			},
+			"quality_cutoff_not_met": schema.BoolAttribute{
+				MarkdownDescription: "Quality cutoff not met flag.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r MovieResource) getCollectionSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Collection title.",
+				Computed:            true,
+			},
+			"tmdb_id": schema.Int64Attribute{
+				MarkdownDescription: "Collection TMDB ID.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -206,9 +569,39 @@ func (r *MovieResource) Configure(ctx context.Context, req resource.ConfigureReq
 	}
 }
 
+func (r *MovieResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to do on create and destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan, state *MovieResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// a new root folder without an explicit path moves the movie folder, the new path is known after apply.
+	if config.Path.IsNull() && !plan.RootFolderPath.Equal(state.RootFolderPath) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("path"), types.StringUnknown())...)
+	}
+
+	// a new path without an explicit root folder may change the root folder.
+	if config.RootFolderPath.IsNull() && !plan.Path.Equal(state.Path) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("root_folder_path"), types.StringUnknown())...)
+	}
+}
+
 func (r *MovieResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var movie *Movie
+	var movie *MovieResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &movie)...)
 
@@ -218,14 +611,11 @@ func (r *MovieResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Create new Movie
 	request := movie.read(ctx, &resp.Diagnostics)
-	// TODO: can parametrize AddMovieOptions
-	options := radarr.NewAddMovieOptions()
-	options.SetMonitor(radarr.MONITORTYPES_MOVIE_ONLY)
-	options.SetSearchForMovie(true)
+	request.AddOptions = movie.readAddOptions(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MovieAPI.CreateMovie(r.auth).MovieResource(*request).Execute()
+	response, _, err := r.client.MovieAPI.CreateMovie(ctx).MovieResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, movieResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, movieResourceName, err)
 
 		return
 	}
@@ -237,8 +627,11 @@ func (r *MovieResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *MovieResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var movie *Movie
+	var movie *MovieResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &movie)...)
 
@@ -247,8 +640,12 @@ func (r *MovieResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Get movie current value
-	response, _, err := r.client.MovieAPI.GetMovieById(r.auth, int32(movie.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MovieAPI.GetMovieById(ctx, int32(movie.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, movieResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, movieResourceName, err))
 
 		return
@@ -261,8 +658,11 @@ func (r *MovieResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *MovieResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var movie *Movie
+	var movie *MovieResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &movie)...)
 
@@ -273,9 +673,20 @@ func (r *MovieResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Update Movie
 	request := movie.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MovieAPI.UpdateMovie(r.auth, fmt.Sprint(request.GetId())).MovieResource(*request).Execute()
+	// keep the current folder name when moving to another root folder.
+	if movie.Path.IsUnknown() {
+		var statePath types.String
+
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("path"), &statePath)...)
+		request.SetPath(moviePathInRootFolder(movie.RootFolderPath.ValueString(), statePath.ValueString()))
+	}
+
+	response, _, err := r.client.MovieAPI.UpdateMovie(ctx, fmt.Sprint(request.GetId())).
+		MoveFiles(movie.MoveFiles.ValueBool()).
+		MovieResource(*request).
+		Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, movieResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, movieResourceName, err)
 
 		return
 	}
@@ -287,31 +698,81 @@ func (r *MovieResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *MovieResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
 
-	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	var movie *MovieResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &movie)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Delete movie current value
-	_, err := r.client.MovieAPI.DeleteMovie(r.auth, int32(ID)).Execute()
+	_, err := r.client.MovieAPI.DeleteMovie(ctx, int32(movie.ID.ValueInt64())).
+		DeleteFiles(movie.DeleteFiles.ValueBool()).
+		AddImportExclusion(movie.AddImportExclusion.ValueBool()).
+		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, movieResourceName, err))
 
 		return
 	}
 
-	tflog.Trace(ctx, "deleted "+movieResourceName+": "+strconv.Itoa(int(ID)))
+	tflog.Trace(ctx, "deleted "+movieResourceName+": "+strconv.Itoa(int(movie.ID.ValueInt64())))
 	resp.State.RemoveResource(ctx)
 }
 
 func (r *MovieResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), movieImportLookups(r.auth.apiContext(ctx), r.client), req, resp)
+	// deletion options are not stored in Radarr, default them to avoid a diff after import.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_files"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("add_import_exclusion"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("move_files"), false)...)
 	tflog.Trace(ctx, "imported "+movieResourceName+": "+req.ID)
 }
 
+// moviePathInRootFolder returns the path of the movie folder moved inside the given root folder.
+func moviePathInRootFolder(rootFolder, moviePath string) string {
+	separator := "/"
+	if strings.Contains(rootFolder, `\`) && !strings.Contains(rootFolder, "/") {
+		separator = `\`
+	}
+
+	moviePath = strings.TrimRight(moviePath, `/\`)
+	folder := moviePath[strings.LastIndexAny(moviePath, `/\`)+1:]
+
+	return strings.TrimRight(rootFolder, `/\`) + separator + folder
+}
+
+// movieImportLookups resolves the movie import identifiers.
+func movieImportLookups(ctx context.Context, client *radarr.APIClient) map[string]helpers.ImportLookup {
+	return map[string]helpers.ImportLookup{
+		"tmdb:": func(value string) (int, error) {
+			tmdbID, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return 0, err
+			}
+
+			movies, _, err := client.MovieAPI.ListMovie(ctx).TmdbId(int32(tmdbID)).Execute()
+			if err != nil {
+				return 0, err
+			}
+
+			return helpers.FindImportID(movies, value, func(m *radarr.MovieResource) string { return strconv.Itoa(int(m.GetTmdbId())) })
+		},
+		"imdb:": func(imdbID string) (int, error) {
+			movies, _, err := client.MovieAPI.ListMovie(ctx).Execute()
+			if err != nil {
+				return 0, err
+			}
+
+			return helpers.FindImportID(movies, imdbID, (*radarr.MovieResource).GetImdbId)
+		},
+	}
+}
+
 func (m *Movie) write(ctx context.Context, movie *radarr.MovieResource, diags *diag.Diagnostics) {
 	var tempDiag diag.Diagnostics
 
@@ -337,13 +798,139 @@ func (m *Movie) write(ctx context.Context, movie *radarr.MovieResource, diags *d
 	diags.Append(tempDiag...)
 	m.Tags, tempDiag = types.SetValueFrom(ctx, types.Int64Type, movie.GetTags())
 	diags.Append(tempDiag...)
+	m.SortTitle = types.StringValue(movie.GetSortTitle())
+	m.Studio = types.StringValue(movie.GetStudio())
+	m.RootFolderPath = types.StringValue(movie.GetRootFolderPath())
+	m.FolderName = types.StringValue(movie.GetFolderName())
+	m.Certification = types.StringValue(movie.GetCertification())
+	m.Runtime = types.Int64Value(int64(movie.GetRuntime()))
+	m.HasFile = types.BoolValue(movie.GetHasFile())
+	m.Popularity = types.Float64Value(float32ToFloat64(movie.GetPopularity()))
+	m.SizeOnDisk = types.Int64Value(movie.GetSizeOnDisk())
+
+	if statistics, ok := movie.GetStatisticsOk(); ok {
+		m.SizeOnDisk = types.Int64Value(statistics.GetSizeOnDisk())
+	}
+
+	m.Added = types.StringNull()
+	if added, ok := movie.GetAddedOk(); ok {
+		m.Added = types.StringValue(added.Format(time.RFC3339))
+	}
+
+	m.writeImages(ctx, movie.GetImages(), diags)
+	m.writeRatings(ctx, movie.GetRatings(), diags)
+	m.writeMovieFile(ctx, movie.MovieFile, diags)
+	m.writeCollection(ctx, movie.Collection, diags)
+}
+
+func (m *Movie) writeImages(ctx context.Context, images []radarr.MediaCover, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	movieImages := make([]MovieImage, len(images))
+	for i, image := range images {
+		movieImages[i] = MovieImage{
+			CoverType: types.StringValue(string(image.GetCoverType())),
+			URL:       types.StringValue(image.GetUrl()),
+			RemoteURL: types.StringValue(image.GetRemoteUrl()),
+		}
+	}
+
+	m.Images, tempDiag = types.SetValueFrom(ctx, MovieImage{}.getType(), movieImages)
+	diags.Append(tempDiag...)
+}
+
+func (m *Movie) writeRatings(ctx context.Context, ratings radarr.Ratings, diags *diag.Diagnostics) {
+	movieRatings := MovieRatings{}
+	for dest, rating := range map[*types.Object]*radarr.RatingChild{
+		&movieRatings.IMDB:           ratings.Imdb,
+		&movieRatings.TMDB:           ratings.Tmdb,
+		&movieRatings.Metacritic:     ratings.Metacritic,
+		&movieRatings.RottenTomatoes: ratings.RottenTomatoes,
+		&movieRatings.Trakt:          ratings.Trakt,
+	} {
+		if rating == nil {
+			assignNullObject(diags, dest, "rating", MovieRating{}.getType())
+
+			continue
+		}
+
+		assignObjectValue(ctx, diags, dest, "rating", MovieRating{
+			Type:  types.StringValue(string(rating.GetType())),
+			Votes: types.Int64Value(int64(rating.GetVotes())),
+			Value: types.Float64Value(rating.GetValue()),
+		}, MovieRating{}.getType())
+	}
+
+	assignObjectValue(ctx, diags, &m.Ratings, "ratings", movieRatings, MovieRatings{}.getType())
+}
+
+func (m *Movie) writeMovieFile(ctx context.Context, file *radarr.MovieFileResource, diags *diag.Diagnostics) {
+	if file == nil {
+		assignNullObject(diags, &m.MovieFile, "movie_file", MovieFileInfo{}.getType())
+
+		return
+	}
+
+	qualityModel := file.GetQuality()
+	quality := qualityModel.GetQuality()
+
+	movieFile := MovieFileInfo{
+		RelativePath:        types.StringValue(file.GetRelativePath()),
+		Path:                types.StringValue(file.GetPath()),
+		Quality:             types.StringValue(quality.GetName()),
+		ReleaseGroup:        types.StringValue(file.GetReleaseGroup()),
+		Edition:             types.StringValue(file.GetEdition()),
+		DateAdded:           types.StringValue(file.GetDateAdded().Format(time.RFC3339)),
+		ID:                  types.Int64Value(int64(file.GetId())),
+		Size:                types.Int64Value(file.GetSize()),
+		QualityCutoffNotMet: types.BoolValue(file.GetQualityCutoffNotMet()),
+	}
+
+	assignObjectValue(ctx, diags, &m.MovieFile, "movie_file", movieFile, MovieFileInfo{}.getType())
+}
+
+func (m *Movie) writeCollection(ctx context.Context, collection *radarr.MovieCollectionResource, diags *diag.Diagnostics) {
+	if collection == nil {
+		assignNullObject(diags, &m.Collection, "collection", MovieCollection{}.getType())
+
+		return
+	}
+
+	movieCollection := MovieCollection{
+		Title:  types.StringValue(collection.GetTitle()),
+		TMDBID: types.Int64Value(int64(collection.GetTmdbId())),
+	}
+
+	assignObjectValue(ctx, diags, &m.Collection, "collection", movieCollection, MovieCollection{}.getType())
+}
+
+// float32ToFloat64 converts a float32 without adding precision noise (e.g. 12.3 instead of 12.300000190734863).
+func float32ToFloat64(value float32) float64 {
+	converted, _ := strconv.ParseFloat(strconv.FormatFloat(float64(value), 'f', -1, 32), 64)
+
+	return converted
+}
+
+func (m *MovieResourceModel) readAddOptions(ctx context.Context, diags *diag.Diagnostics) *radarr.AddMovieOptions {
+	if m.AddOptions.IsNull() || m.AddOptions.IsUnknown() {
+		return nil
+	}
+
+	addOptions := AddMovieOptions{}
+	diags.Append(m.AddOptions.As(ctx, &addOptions, basetypes.ObjectAsOptions{})...)
+
+	options := radarr.NewAddMovieOptions()
+	options.SetMonitor(radarr.MonitorTypes(addOptions.Monitor.ValueString()))
+	options.SetAddMethod(radarr.AddMovieMethod(addOptions.AddMethod.ValueString()))
+	options.SetSearchForMovie(addOptions.SearchForMovie.ValueBool())
+
+	return options
 }
 
 func (m *Movie) read(ctx context.Context, diags *diag.Diagnostics) *radarr.MovieResource {
 	movie := radarr.NewMovieResource()
 	movie.SetMonitored(m.Monitored.ValueBool())
 	movie.SetTitle(m.Title.ValueString())
-	movie.SetPath(m.Path.ValueString())
 	movie.SetQualityProfileId(int32(m.QualityProfileID.ValueInt64()))
 	movie.SetTmdbId(int32(m.TMDBID.ValueInt64()))
 	movie.SetId(int32(m.ID.ValueInt64()))
@@ -353,5 +940,14 @@ func (m *Movie) read(ctx context.Context, diags *diag.Diagnostics) *radarr.Movie
 		movie.SetMinimumAvailability(radarr.MovieStatusType(m.MinimumAvailability.ValueString()))
 	}
 
+	// without a path Radarr builds the movie folder inside the root folder.
+	if !m.Path.IsNull() && !m.Path.IsUnknown() {
+		movie.SetPath(m.Path.ValueString())
+	}
+
+	if !m.RootFolderPath.IsNull() && !m.RootFolderPath.IsUnknown() {
+		movie.SetRootFolderPath(m.RootFolderPath.ValueString())
+	}
+
 	return movie
 }
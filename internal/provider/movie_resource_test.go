@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -37,6 +38,14 @@ func TestAccMovieResource(t *testing.T) {
 					resource.TestCheckResourceAttr("radarr_movie.test", "original_language.id", "1"),
 					resource.TestCheckResourceAttr("radarr_movie.test", "original_language.name", "English"),
 					resource.TestCheckResourceAttr("radarr_movie.test", "genres.0", "Action"),
+					resource.TestCheckResourceAttr("radarr_movie.test", "has_file", "false"),
+					resource.TestCheckResourceAttr("radarr_movie.test", "size_on_disk", "0"),
+					resource.TestCheckResourceAttr("radarr_movie.test", "collection.title", "The Matrix Collection"),
+					resource.TestCheckResourceAttrSet("radarr_movie.test", "added"),
+					resource.TestCheckResourceAttr("radarr_movie.test", "add_options.monitor", "movieOnly"),
+					resource.TestCheckResourceAttr("radarr_movie.test", "add_options.search_for_movie", "false"),
+					resource.TestCheckResourceAttr("radarr_movie.test", "delete_files", "true"),
+					resource.TestCheckResourceAttr("radarr_movie.test", "add_import_exclusion", "false"),
 				),
 			},
 			// Unauthorized Read
@@ -53,9 +62,70 @@ func TestAccMovieResource(t *testing.T) {
 			},
 			// ImportState testing
 			{
-				ResourceName:      "radarr_movie.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "radarr_movie.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"add_options", "delete_files"},
+			},
+			// ImportState by tmdb testing
+			{
+				ResourceName:            "radarr_movie.test",
+				ImportStateId:           "tmdb:603",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"add_options", "delete_files"},
+			},
+			// ImportState by imdb testing
+			{
+				ResourceName:            "radarr_movie.test",
+				ImportStateId:           "imdb:tt0133093",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"add_options", "delete_files"},
+			},
+			// Remote deletion testing
+			{
+				PreConfig:          func() { movieDelete(603) },
+				Config:             testAccMovieResourceConfig("The Matrix", "test123", 603),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMovieResourceRootFolder(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccMovieResourceRootFolderConfig("/config"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_movie.test", "root_folder_path", "/config"),
+					resource.TestCheckResourceAttr("radarr_movie.test", "path", "/config/Forrest Gump (1994)"),
+					resource.TestCheckResourceAttr("radarr_movie.test", "move_files", "true"),
+				),
+			},
+			// Move and Read testing
+			{
+				Config: testAccMovieResourceMoveConfig("/config", "/config/Forrest Gump"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_movie.test", "root_folder_path", "/config"),
+					resource.TestCheckResourceAttr("radarr_movie.test", "path", "/config/Forrest Gump"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "radarr_movie.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"add_options", "move_files"},
 			},
 			// Delete testing automatically occurs in TestCase
 		},
@@ -72,6 +142,54 @@ func testAccMovieResourceConfig(title, path string, tmdbID int) string {
 			tmdb_id = %d
 
 			minimum_availability = "inCinemas"
+			delete_files = true
+			add_import_exclusion = false
+
+			add_options = {
+				monitor = "movieOnly"
+				search_for_movie = false
+			}
+
+			timeouts = {
+				create = "10m"
+			}
 		}
 	`, title, path, tmdbID)
 }
+
+func testAccMovieResourceRootFolderConfig(rootFolder string) string {
+	return fmt.Sprintf(`
+		resource "radarr_movie" "test" {
+			monitored = false
+			title = "Forrest Gump"
+			root_folder_path = "%s"
+			quality_profile_id = 1
+			tmdb_id = 13
+			move_files = true
+		}
+	`, rootFolder)
+}
+
+func testAccMovieResourceMoveConfig(rootFolder, path string) string {
+	return fmt.Sprintf(`
+		resource "radarr_movie" "test" {
+			monitored = false
+			title = "Forrest Gump"
+			root_folder_path = "%s"
+			path = "%s"
+			quality_profile_id = 1
+			tmdb_id = 13
+			move_files = true
+		}
+	`, rootFolder, path)
+}
+
+func movieDelete(tmdbID int32) {
+	// delete the movie outside of terraform to simulate drift
+	client := testAccAPIClient()
+
+	movies, _, _ := client.MovieAPI.ListMovie(context.TODO()).TmdbId(tmdbID).Execute()
+	for _, m := range movies {
+		_, _ = client.MovieAPI.DeleteMovie(context.TODO(), m.GetId()).Execute()
+	}
+}
@@ -24,7 +24,7 @@ func NewMoviesDataSource() datasource.DataSource {
 // MoviesDataSource defines the movies implementation.
 type MoviesDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // Movies describes the movies data model.
@@ -135,6 +135,68 @@ func (d *MoviesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 								},
 							},
 						},
+						"sort_title": schema.StringAttribute{
+							MarkdownDescription: "Sort title.",
+							Computed:            true,
+						},
+						"studio": schema.StringAttribute{
+							MarkdownDescription: "Studio.",
+							Computed:            true,
+						},
+						"root_folder_path": schema.StringAttribute{
+							MarkdownDescription: "Root folder path.",
+							Computed:            true,
+						},
+						"folder_name": schema.StringAttribute{
+							MarkdownDescription: "Folder name.",
+							Computed:            true,
+						},
+						"certification": schema.StringAttribute{
+							MarkdownDescription: "Certification.",
+							Computed:            true,
+						},
+						"added": schema.StringAttribute{
+							MarkdownDescription: "Date the movie was added, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"size_on_disk": schema.Int64Attribute{
+							MarkdownDescription: "Size on disk in bytes.",
+							Computed:            true,
+						},
+						"runtime": schema.Int64Attribute{
+							MarkdownDescription: "Runtime in minutes.",
+							Computed:            true,
+						},
+						"popularity": schema.Float64Attribute{
+							MarkdownDescription: "Popularity.",
+							Computed:            true,
+						},
+						"has_file": schema.BoolAttribute{
+							MarkdownDescription: "Has file flag.",
+							Computed:            true,
+						},
+						"images": schema.SetNestedAttribute{
+							MarkdownDescription: "Images.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: MovieDataSource{}.getImageSchema().Attributes,
+							},
+						},
+						"ratings": schema.SingleNestedAttribute{
+							MarkdownDescription: "Ratings.",
+							Computed:            true,
+							Attributes:          MovieDataSource{}.getRatingsSchema().Attributes,
+						},
+						"movie_file": schema.SingleNestedAttribute{
+							MarkdownDescription: "Movie file.",
+							Computed:            true,
+							Attributes:          MovieDataSource{}.getMovieFileSchema().Attributes,
+						},
+						"collection": schema.SingleNestedAttribute{
+							MarkdownDescription: "Collection.",
+							Computed:            true,
+							Attributes:          MovieDataSource{}.getCollectionSchema().Attributes,
+						},
 					},
 				},
 			},
@@ -150,8 +212,10 @@ func (d *MoviesDataSource) Configure(ctx context.Context, req datasource.Configu
 }
 
 func (d *MoviesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get movies current value
-	response, _, err := d.client.MovieAPI.ListMovie(d.auth).Execute()
+	response, _, err := d.client.MovieAPI.ListMovie(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, moviesDataSourceName, err))
 
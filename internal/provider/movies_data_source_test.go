@@ -23,7 +23,7 @@ func TestAccMoviesDataSource(t *testing.T) {
 			{
 				Config: testAccMovieResourceConfig("Gladiator", "Gladiator_2000", 98) + testAccMoviesDataSourceConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckTypeSetElemNestedAttrs("data.radarr_movies.test", "movies.*", map[string]string{"title": "Gladiator"}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.radarr_movies.test", "movies.*", map[string]string{"title": "Gladiator", "has_file": "false"}),
 				),
 			},
 		},
@@ -0,0 +1,571 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const moviesResourceName = "movies"
+
+var errMoviesNotAdded = errors.New("movies not added by Radarr")
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource               = &MoviesResource{}
+	_ resource.ResourceWithModifyPlan = &MoviesResource{}
+)
+
+func NewMoviesResource() resource.Resource {
+	return &MoviesResource{}
+}
+
+// MoviesResource defines the bulk movies implementation.
+type MoviesResource struct {
+	client *radarr.APIClient
+	auth   radarrAuth
+}
+
+// MoviesResourceModel describes the bulk movies resource data model.
+type MoviesResourceModel struct {
+	TMDBIDs             types.Set    `tfsdk:"tmdb_ids"`
+	Tags                types.Set    `tfsdk:"tags"`
+	MovieIDs            types.Map    `tfsdk:"movie_ids"`
+	Timeouts            types.Object `tfsdk:"timeouts"`
+	ID                  types.String `tfsdk:"id"`
+	RootFolderPath      types.String `tfsdk:"root_folder_path"`
+	MinimumAvailability types.String `tfsdk:"minimum_availability"`
+	QualityProfileID    types.Int64  `tfsdk:"quality_profile_id"`
+	Monitored           types.Bool   `tfsdk:"monitored"`
+	SearchForMovie      types.Bool   `tfsdk:"search_for_movie"`
+	MoveFiles           types.Bool   `tfsdk:"move_files"`
+	DeleteFiles         types.Bool   `tfsdk:"delete_files"`
+	AddImportExclusion  types.Bool   `tfsdk:"add_import_exclusion"`
+}
+
+func (r *MoviesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + moviesResourceName
+}
+
+func (r *MoviesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Movies -->\nMovies bulk resource.\nIt manages a set of movies sharing the same settings through the movie import and movie editor endpoints, use it instead of [Movie](../resources/movie) for large libraries.\nFor more information refer to [Movies](https://wiki.servarr.com/radarr/library#movies) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier, generated on creation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tmdb_ids": schema.SetAttribute{
+				MarkdownDescription: "TMDB IDs of the managed movies. Movies already in Radarr are adopted, the missing ones are added.",
+				Required:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality profile ID.",
+				Required:            true,
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Root folder path of the added movies. Radarr builds each movie path using the `movie_folder_format` naming setting. Existing movies in a different root folder are only moved when `move_files` is set.",
+				Required:            true,
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag.",
+				Required:            true,
+			},
+			"minimum_availability": schema.StringAttribute{
+				MarkdownDescription: "Minimum availability.\nAllowed values: 'tba', 'announced', 'inCinemas', 'released', 'deleted'.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(radarr.MOVIESTATUSTYPE_RELEASED)),
+				Validators: []validator.String{
+					stringvalidator.OneOf("tba", "announced", "inCinemas", "released", "deleted"),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags. They replace the tags of every managed movie, the tags are left untouched when omitted.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"search_for_movie": schema.BoolAttribute{
+				MarkdownDescription: "Search for the movies when they are added to Radarr.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"move_files": schema.BoolAttribute{
+				MarkdownDescription: "Move the existing movies in a different root folder, together with their files, to `root_folder_path`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"delete_files": schema.BoolAttribute{
+				MarkdownDescription: "Delete the movie files from disk when a movie is removed from `tmdb_ids` or the resource is destroyed. The value is stored in state, so it must be applied before destroying the resource.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"add_import_exclusion": schema.BoolAttribute{
+				MarkdownDescription: "Add an import list exclusion when a movie is removed from `tmdb_ids` or the resource is destroyed. The value is stored in state, so it must be applied before destroying the resource.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"movie_ids": schema.MapAttribute{
+				MarkdownDescription: "Movie IDs, keyed by TMDB ID.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+		},
+	}
+}
+
+func (r *MoviesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *MoviesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *MoviesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	// movie IDs only change when the managed movies change.
+	if !resp.Diagnostics.HasError() && plan.TMDBIDs.Equal(state.TMDBIDs) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("movie_ids"), state.MovieIDs)...)
+	}
+}
+
+func (r *MoviesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
+	// Retrieve values from plan
+	var movies *MoviesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &movies)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ResourceError, fmt.Sprintf("Unable to generate %s ID, got error: %s", moviesResourceName, err))
+
+		return
+	}
+
+	movies.ID = types.StringValue(id)
+
+	// Add and update movies
+	r.apply(ctx, req.Plan, helpers.Create, movies, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+moviesResourceName+": "+movies.ID.ValueString())
+	// Generate resource state struct
+	resp.Diagnostics.Append(resp.State.Set(ctx, &movies)...)
+}
+
+func (r *MoviesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
+	// Get current state
+	var movies *MoviesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &movies)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get movies current value with a single call
+	response, _, err := r.client.MovieAPI.ListMovie(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, moviesResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+moviesResourceName+": "+movies.ID.ValueString())
+	// Map response body to resource schema attribute
+	movies.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &movies)...)
+}
+
+func (r *MoviesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
+	// Get plan and state values
+	var movies, state *MoviesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &movies)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the movies removed from the set
+	var tmdbIDs []int64
+
+	resp.Diagnostics.Append(movies.TMDBIDs.ElementsAs(ctx, &tmdbIDs, false)...)
+
+	removed := state.readMovieIDs(ctx, func(tmdbID int64) bool { return !slices.Contains(tmdbIDs, tmdbID) }, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(removed) > 0 {
+		editor := radarr.NewMovieEditorResource()
+		editor.SetMovieIds(removed)
+		editor.SetDeleteFiles(movies.DeleteFiles.ValueBool())
+		editor.SetAddImportExclusion(movies.AddImportExclusion.ValueBool())
+
+		if _, err := r.client.MovieEditorAPI.DeleteMovieEditor(ctx).MovieEditorResource(*editor).Execute(); err != nil {
+			helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, moviesResourceName, err)
+
+			return
+		}
+	}
+
+	// Add and update movies
+	r.apply(ctx, req.Plan, helpers.Update, movies, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+moviesResourceName+": "+movies.ID.ValueString())
+	// Generate resource state struct
+	resp.Diagnostics.Append(resp.State.Set(ctx, &movies)...)
+}
+
+func (r *MoviesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	var movies *MoviesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &movies)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete movies current value
+	ids := movies.readMovieIDs(ctx, func(int64) bool { return true }, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(ids) > 0 {
+		editor := radarr.NewMovieEditorResource()
+		editor.SetMovieIds(ids)
+		editor.SetDeleteFiles(movies.DeleteFiles.ValueBool())
+		editor.SetAddImportExclusion(movies.AddImportExclusion.ValueBool())
+
+		if _, err := r.client.MovieEditorAPI.DeleteMovieEditor(ctx).MovieEditorResource(*editor).Execute(); err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, moviesResourceName, err))
+
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "deleted "+moviesResourceName+": "+movies.ID.ValueString())
+	resp.State.RemoveResource(ctx)
+}
+
+// apply adds the missing movies, applies the shared settings to the existing ones and reads the result back.
+func (r *MoviesResource) apply(ctx context.Context, source helpers.AttributeGetter, action string, movies *MoviesResourceModel, diags *diag.Diagnostics) {
+	var (
+		tmdbIDs, tags   []int64
+		existing, moved []int32
+		currentTags     []int32
+	)
+
+	diags.Append(movies.TMDBIDs.ElementsAs(ctx, &tmdbIDs, false)...)
+
+	diags.Append(movies.Tags.ElementsAs(ctx, &tags, false)...)
+
+	if diags.HasError() {
+		return
+	}
+
+	library, _, err := r.client.MovieAPI.ListMovie(ctx).Execute()
+	if err != nil {
+		helpers.AddClientError(ctx, diags, source, action, moviesResourceName, err)
+
+		return
+	}
+
+	// split the movies already in Radarr from the ones to be added.
+	for _, m := range library {
+		if index := slices.Index(tmdbIDs, int64(m.GetTmdbId())); index >= 0 {
+			existing = append(existing, m.GetId())
+			tmdbIDs = slices.Delete(tmdbIDs, index, index+1)
+
+			if !sameRootFolder(m.GetRootFolderPath(), movies.RootFolderPath.ValueString()) {
+				moved = append(moved, m.GetId())
+			}
+
+			for _, tag := range m.GetTags() {
+				if !slices.Contains(currentTags, tag) {
+					currentTags = append(currentTags, tag)
+				}
+			}
+		}
+	}
+
+	if len(tmdbIDs) > 0 {
+		if _, _, err = r.client.MovieImportAPI.CreateMovieImport(ctx).MovieResource(movies.readImport(tmdbIDs, tags)).Execute(); err != nil {
+			helpers.AddClientError(ctx, diags, source, action, moviesResourceName, err)
+
+			return
+		}
+	}
+
+	if len(existing) > 0 {
+		for _, editor := range movies.readEditors(existing, moved, tags, currentTags) {
+			if _, err = r.client.MovieEditorAPI.PutMovieEditor(ctx).MovieEditorResource(*editor).Execute(); err != nil {
+				helpers.AddClientError(ctx, diags, source, action, moviesResourceName, err)
+
+				return
+			}
+		}
+	}
+
+	library, _, err = r.client.MovieAPI.ListMovie(ctx).Execute()
+	if err != nil {
+		helpers.AddClientError(ctx, diags, source, action, moviesResourceName, err)
+
+		return
+	}
+
+	// the import skips the movies it cannot add, report them instead of an inconsistent result.
+	missing := make([]string, 0, len(tmdbIDs))
+
+	for _, tmdbID := range tmdbIDs {
+		if !slices.ContainsFunc(library, func(m radarr.MovieResource) bool { return int64(m.GetTmdbId()) == tmdbID }) {
+			missing = append(missing, strconv.FormatInt(tmdbID, 10))
+		}
+	}
+
+	if len(missing) > 0 {
+		diags.AddAttributeError(
+			path.Root("tmdb_ids"),
+			helpers.ClientError,
+			helpers.ParseClientError(action, moviesResourceName, fmt.Errorf("%w: TMDB IDs %s", errMoviesNotAdded, strings.Join(missing, ", "))),
+		)
+
+		return
+	}
+
+	movies.write(ctx, library, diags)
+}
+
+func (m *MoviesResourceModel) write(ctx context.Context, library []radarr.MovieResource, diags *diag.Diagnostics) {
+	var (
+		tmdbIDs  []int64
+		tempDiag diag.Diagnostics
+	)
+
+	diags.Append(m.TMDBIDs.ElementsAs(ctx, &tmdbIDs, false)...)
+
+	managed := make(map[int64]bool, len(tmdbIDs))
+	for _, id := range tmdbIDs {
+		managed[id] = true
+	}
+
+	found := make([]int64, 0, len(tmdbIDs))
+	movieIDs := make(map[string]int64, len(tmdbIDs))
+
+	for i := range library {
+		movie := &library[i]
+		if !managed[int64(movie.GetTmdbId())] {
+			continue
+		}
+
+		found = append(found, int64(movie.GetTmdbId()))
+		movieIDs[strconv.Itoa(int(movie.GetTmdbId()))] = int64(movie.GetId())
+
+		m.writeMovie(ctx, movie, diags)
+	}
+
+	m.TMDBIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, found)
+	diags.Append(tempDiag...)
+	m.MovieIDs, tempDiag = types.MapValueFrom(ctx, types.Int64Type, movieIDs)
+	diags.Append(tempDiag...)
+}
+
+// writeMovie reports the settings of a managed movie differing from the shared ones, so that they show up as drift.
+// Tags are only compared when configured, since each movie keeps its own tags otherwise.
+func (m *MoviesResourceModel) writeMovie(ctx context.Context, movie *radarr.MovieResource, diags *diag.Diagnostics) {
+	if !m.Tags.IsNull() {
+		tags, tempDiag := types.SetValueFrom(ctx, types.Int64Type, movie.GetTags())
+		diags.Append(tempDiag...)
+
+		if !tags.Equal(m.Tags) {
+			m.Tags = tags
+		}
+	}
+
+	if int64(movie.GetQualityProfileId()) != m.QualityProfileID.ValueInt64() {
+		m.QualityProfileID = types.Int64Value(int64(movie.GetQualityProfileId()))
+	}
+
+	if movie.GetMonitored() != m.Monitored.ValueBool() {
+		m.Monitored = types.BoolValue(movie.GetMonitored())
+	}
+
+	if string(movie.GetMinimumAvailability()) != m.MinimumAvailability.ValueString() {
+		m.MinimumAvailability = types.StringValue(string(movie.GetMinimumAvailability()))
+	}
+
+	// movies in a different root folder are only managed when they can be moved.
+	if root := movie.GetRootFolderPath(); m.MoveFiles.ValueBool() && !sameRootFolder(root, m.RootFolderPath.ValueString()) {
+		m.RootFolderPath = types.StringValue(root)
+	}
+}
+
+// sameRootFolder compares two root folder paths ignoring the trailing separator, an unknown root matches any.
+func sameRootFolder(root, other string) bool {
+	return root == "" || strings.TrimRight(root, `/\`) == strings.TrimRight(other, `/\`)
+}
+
+// readMovieIDs returns the IDs of the movies in state whose TMDB ID matches the filter.
+func (m *MoviesResourceModel) readMovieIDs(ctx context.Context, filter func(int64) bool, diags *diag.Diagnostics) []int32 {
+	movieIDs := make(map[string]int64)
+	diags.Append(m.MovieIDs.ElementsAs(ctx, &movieIDs, false)...)
+
+	ids := make([]int32, 0, len(movieIDs))
+
+	for tmdbID, id := range movieIDs {
+		value, err := strconv.ParseInt(tmdbID, 10, 64)
+		if err == nil && filter(value) {
+			ids = append(ids, int32(id))
+		}
+	}
+
+	return ids
+}
+
+func (m *MoviesResourceModel) readImport(tmdbIDs, tags []int64) []radarr.MovieResource {
+	options := radarr.NewAddMovieOptions()
+	options.SetSearchForMovie(m.SearchForMovie.ValueBool())
+	options.SetAddMethod(radarr.ADDMOVIEMETHOD_MANUAL)
+	options.SetMonitor(radarr.MONITORTYPES_MOVIE_ONLY)
+
+	if !m.Monitored.ValueBool() {
+		options.SetMonitor(radarr.MONITORTYPES_NONE)
+	}
+
+	movies := make([]radarr.MovieResource, len(tmdbIDs))
+	for i, tmdbID := range tmdbIDs {
+		movie := radarr.NewMovieResource()
+		movie.SetTmdbId(int32(tmdbID))
+		movie.SetQualityProfileId(int32(m.QualityProfileID.ValueInt64()))
+		movie.SetRootFolderPath(m.RootFolderPath.ValueString())
+		movie.SetMonitored(m.Monitored.ValueBool())
+		movie.SetMinimumAvailability(radarr.MovieStatusType(m.MinimumAvailability.ValueString()))
+		movie.SetAddOptions(*options)
+
+		for _, tag := range tags {
+			movie.Tags = append(movie.Tags, int32(tag))
+		}
+
+		movies[i] = *movie
+	}
+
+	return movies
+}
+
+// readEditors returns the movie editor requests applying the shared settings.
+// Only the moved movies get the root folder, otherwise Radarr would repath them without moving their files.
+// Radarr ignores an empty tag list, so clearing tags requires a separate removal request.
+func (m *MoviesResourceModel) readEditors(ids, moved []int32, tags []int64, currentTags []int32) []*radarr.MovieEditorResource {
+	editor := radarr.NewMovieEditorResource()
+	editor.SetMovieIds(ids)
+	editor.SetQualityProfileId(int32(m.QualityProfileID.ValueInt64()))
+	editor.SetMonitored(m.Monitored.ValueBool())
+	editor.SetMinimumAvailability(radarr.MovieStatusType(m.MinimumAvailability.ValueString()))
+
+	editors := []*radarr.MovieEditorResource{editor}
+
+	if m.MoveFiles.ValueBool() && len(moved) > 0 {
+		move := radarr.NewMovieEditorResource()
+		move.SetMovieIds(moved)
+		move.SetRootFolderPath(m.RootFolderPath.ValueString())
+		move.SetMoveFiles(true)
+		editors = append(editors, move)
+	}
+
+	switch {
+	case m.Tags.IsNull() || m.Tags.IsUnknown():
+		return editors
+	case len(tags) > 0:
+		for _, tag := range tags {
+			editor.Tags = append(editor.Tags, int32(tag))
+		}
+
+		editor.SetApplyTags(radarr.APPLYTAGS_REPLACE)
+	case len(currentTags) > 0:
+		remove := radarr.NewMovieEditorResource()
+		remove.SetMovieIds(ids)
+		remove.SetTags(currentTags)
+		remove.SetApplyTags(radarr.APPLYTAGS_REMOVE)
+		editors = append(editors, remove)
+	}
+
+	return editors
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccMoviesResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccMoviesResourceConfig("[550]", false) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccMoviesResourceConfig("[550, 807]", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("radarr_movies.test", "id"),
+					resource.TestCheckResourceAttr("radarr_movies.test", "tmdb_ids.#", "2"),
+					resource.TestCheckResourceAttr("radarr_movies.test", "movie_ids.%", "2"),
+					resource.TestCheckResourceAttrSet("radarr_movies.test", "movie_ids.550"),
+					resource.TestCheckResourceAttr("radarr_movies.test", "monitored", "false"),
+					resource.TestCheckResourceAttr("radarr_movies.test", "minimum_availability", "released"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccMoviesResourceConfig("[550, 807]", false) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccMoviesResourceConfig("[550, 1422]", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_movies.test", "tmdb_ids.#", "2"),
+					resource.TestCheckResourceAttrSet("radarr_movies.test", "movie_ids.1422"),
+					resource.TestCheckNoResourceAttr("radarr_movies.test", "movie_ids.807"),
+					resource.TestCheckResourceAttr("radarr_movies.test", "monitored", "true"),
+				),
+			},
+			// Remote deletion testing
+			{
+				PreConfig:          func() { movieDelete(1422) },
+				Config:             testAccMoviesResourceConfig("[550, 1422]", true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccMoviesResourceConfig(tmdbIDs string, monitored bool) string {
+	return fmt.Sprintf(`
+		resource "radarr_movies" "test" {
+			tmdb_ids = %s
+			quality_profile_id = 1
+			root_folder_path = "/config"
+			monitored = %t
+			delete_files = true
+		}
+	`, tmdbIDs, monitored)
+}
+
+func TestMoviesResourceTags(t *testing.T) {
+	t.Parallel()
+
+	movie := func(id, tmdbID int32, tags ...int32) radarr.MovieResource {
+		m := radarr.NewMovieResource()
+		m.SetId(id)
+		m.SetTmdbId(tmdbID)
+		m.SetTags(tags)
+		m.SetQualityProfileId(1)
+		m.SetMonitored(true)
+		m.SetMinimumAvailability(radarr.MOVIESTATUSTYPE_RELEASED)
+		m.SetRootFolderPath("/movies")
+
+		return *m
+	}
+	library := []radarr.MovieResource{movie(1, 11, 1), movie(2, 12, 2, 3)}
+
+	tests := map[string]struct {
+		tags     types.Set
+		expected types.Set
+		editors  int
+	}{
+		"omitted": {
+			tags:     types.SetNull(types.Int64Type),
+			expected: types.SetNull(types.Int64Type),
+			editors:  1,
+		},
+		"configured": {
+			tags:     types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1)}),
+			expected: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(2), types.Int64Value(3)}),
+			editors:  1,
+		},
+		"cleared": {
+			tags:     types.SetValueMust(types.Int64Type, []attr.Value{}),
+			expected: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(2), types.Int64Value(3)}),
+			editors:  2,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			movies := &MoviesResourceModel{
+				TMDBIDs:             types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(11), types.Int64Value(12)}),
+				Tags:                test.tags,
+				RootFolderPath:      types.StringValue("/movies"),
+				MinimumAvailability: types.StringValue(string(radarr.MOVIESTATUSTYPE_RELEASED)),
+				QualityProfileID:    types.Int64Value(1),
+				Monitored:           types.BoolValue(true),
+				MoveFiles:           types.BoolValue(false),
+			}
+
+			// drift is only reported against configured tags.
+			movies.write(context.Background(), library, &diags)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, test.expected, movies.Tags)
+
+			movies.Tags = test.tags
+
+			var tags []int64
+
+			diags.Append(test.tags.ElementsAs(context.Background(), &tags, false)...)
+
+			editors := movies.readEditors([]int32{1, 2}, nil, tags, []int32{1, 2, 3})
+			assert.Len(t, editors, test.editors)
+
+			// omitted tags are not sent, so that each movie keeps its own.
+			if test.tags.IsNull() {
+				assert.Empty(t, editors[0].GetTags())
+				assert.False(t, editors[0].HasApplyTags())
+			}
+		})
+	}
+}
@@ -22,7 +22,7 @@ func NewNamingDataSource() datasource.DataSource {
 // NamingDataSource defines the naming implementation.
 type NamingDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *NamingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -70,8 +70,10 @@ func (d *NamingDataSource) Configure(ctx context.Context, req datasource.Configu
 }
 
 func (d *NamingDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get naming current value
-	response, _, err := d.client.NamingConfigAPI.GetNamingConfig(d.auth).Execute()
+	response, _, err := d.client.NamingConfigAPI.GetNamingConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, namingDataSourceName, err))
 
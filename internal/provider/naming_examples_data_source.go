@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const namingExamplesDataSourceName = "naming_examples"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NamingExamplesDataSource{}
+
+func NewNamingExamplesDataSource() datasource.DataSource {
+	return &NamingExamplesDataSource{}
+}
+
+// NamingExamplesDataSource defines the naming examples implementation.
+type NamingExamplesDataSource struct {
+	client *radarr.APIClient
+	auth   radarrAuth
+}
+
+// NamingExamples describes the naming examples data model.
+type NamingExamples struct {
+	ID                       types.String `tfsdk:"id"`
+	ColonReplacementFormat   types.String `tfsdk:"colon_replacement_format"`
+	StandardMovieFormat      types.String `tfsdk:"standard_movie_format"`
+	MovieFolderFormat        types.String `tfsdk:"movie_folder_format"`
+	MovieExample             types.String `tfsdk:"movie_example"`
+	MovieFolderExample       types.String `tfsdk:"movie_folder_example"`
+	ReplaceIllegalCharacters types.Bool   `tfsdk:"replace_illegal_characters"`
+}
+
+// namingExamplesResponse is the body of the naming examples endpoint, the client does not decode it.
+type namingExamplesResponse struct {
+	MovieExample       string `json:"movieExample"`
+	MovieFolderExample string `json:"movieFolderExample"`
+}
+
+func (d *NamingExamplesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + namingExamplesDataSourceName
+}
+
+func (d *NamingExamplesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Media Management -->\nExamples rendered by Radarr for candidate [Naming](../resources/naming) formats, without changing the current settings.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"standard_movie_format": schema.StringAttribute{
+				MarkdownDescription: "Standard movie format.",
+				Required:            true,
+				Validators: []validator.String{
+					helpers.NamingFormatValidator(false),
+				},
+			},
+			"movie_folder_format": schema.StringAttribute{
+				MarkdownDescription: "Movie folder format.",
+				Required:            true,
+				Validators: []validator.String{
+					helpers.NamingFormatValidator(true),
+				},
+			},
+			"colon_replacement_format": schema.StringAttribute{
+				MarkdownDescription: "Colon replacement format. Valid values are: 'smart', 'delete', 'dash', 'spaceDash', and 'spaceDashSpace'. Defaults to the current setting.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("delete", "dash", "spaceDash", "spaceDashSpace", "smart"),
+				},
+			},
+			"replace_illegal_characters": schema.BoolAttribute{
+				MarkdownDescription: "Replace illegal characters. Defaults to the current setting.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"movie_example": schema.StringAttribute{
+				MarkdownDescription: "Movie file example.",
+				Computed:            true,
+			},
+			"movie_folder_example": schema.StringAttribute{
+				MarkdownDescription: "Movie folder example.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *NamingExamplesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *NamingExamplesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
+	var data *NamingExamples
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get naming current value for the settings not given
+	naming, _, err := d.client.NamingConfigAPI.GetNamingConfig(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, namingResourceName, err))
+
+		return
+	}
+
+	if data.ColonReplacementFormat.IsNull() {
+		data.ColonReplacementFormat = types.StringValue(string(naming.GetColonReplacementFormat()))
+	}
+
+	if data.ReplaceIllegalCharacters.IsNull() {
+		data.ReplaceIllegalCharacters = types.BoolValue(naming.GetReplaceIllegalCharacters())
+	}
+
+	// Get naming examples, the client does not decode them
+	httpResp, err := d.client.NamingConfigAPI.GetNamingConfigExamples(ctx).
+		RenameMovies(true).
+		ReplaceIllegalCharacters(data.ReplaceIllegalCharacters.ValueBool()).
+		ColonReplacementFormat(radarr.ColonReplacementFormat(data.ColonReplacementFormat.ValueString())).
+		StandardMovieFormat(data.StandardMovieFormat.ValueString()).
+		MovieFolderFormat(data.MovieFolderFormat.ValueString()).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, namingExamplesDataSourceName, err))
+
+		return
+	}
+
+	var examples namingExamplesResponse
+
+	err = json.NewDecoder(httpResp.Body).Decode(&examples)
+	httpResp.Body.Close()
+
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, namingExamplesDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+namingExamplesDataSourceName)
+	// Map response body to resource schema attribute
+	data.write(&examples)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (n *NamingExamples) write(examples *namingExamplesResponse) {
+	n.ID = types.StringValue(n.StandardMovieFormat.ValueString())
+	n.MovieExample = types.StringValue(examples.MovieExample)
+	n.MovieFolderExample = types.StringValue(examples.MovieFolderExample)
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNamingExamplesDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccNamingExamplesDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccNamingExamplesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.radarr_naming_examples.test", "colon_replacement_format", "delete"),
+					resource.TestCheckResourceAttrSet("data.radarr_naming_examples.test", "replace_illegal_characters"),
+					resource.TestMatchResourceAttr("data.radarr_naming_examples.test", "movie_example", regexp.MustCompile(`\(2010\) \[`)),
+					resource.TestMatchResourceAttr("data.radarr_naming_examples.test", "movie_folder_example", regexp.MustCompile(`^\d{4}$`)),
+				),
+			},
+		},
+	})
+}
+
+const testAccNamingExamplesDataSourceConfig = `
+data "radarr_naming_examples" "test" {
+	standard_movie_format = "{Movie Title} ({Release Year}) [{Quality Full}]"
+	movie_folder_format = "{Release Year}"
+	colon_replacement_format = "delete"
+}
+`
@@ -32,7 +32,7 @@ func NewNamingResource() resource.Resource {
 // NamingResource defines the naming implementation.
 type NamingResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // Naming describes the naming data model.
@@ -45,6 +45,12 @@ type Naming struct {
 	ReplaceIllegalCharacters types.Bool   `tfsdk:"replace_illegal_characters"`
 }
 
+// NamingResourceModel describes the naming resource data model.
+type NamingResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	Naming
+}
+
 func (r *NamingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + namingResourceName
 }
@@ -53,6 +59,7 @@ func (r *NamingResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Media Management -->\nNaming resource.\nFor more information refer to [Naming](https://wiki.servarr.com/radarr/settings#community-naming-suggestions) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Naming ID.",
 				Computed:            true,
@@ -78,10 +85,16 @@ func (r *NamingResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"movie_folder_format": schema.StringAttribute{
 				MarkdownDescription: "Movie folder format.",
 				Required:            true,
+				Validators: []validator.String{
+					helpers.NamingFormatValidator(true),
+				},
 			},
 			"standard_movie_format": schema.StringAttribute{
 				MarkdownDescription: "Standard movie formatss.",
 				Required:            true,
+				Validators: []validator.String{
+					helpers.NamingFormatValidator(false),
+				},
 			},
 		},
 	}
@@ -95,8 +108,11 @@ func (r *NamingResource) Configure(ctx context.Context, req resource.ConfigureRe
 }
 
 func (r *NamingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var naming *Naming
+	var naming *NamingResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &naming)...)
 
@@ -105,7 +121,7 @@ func (r *NamingResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Init call if we remove this it the very first update on a brand new instance will fail
-	if _, _, err := r.client.NamingConfigAPI.GetNamingConfig(r.auth).Execute(); err != nil {
+	if _, _, err := r.client.NamingConfigAPI.GetNamingConfig(ctx).Execute(); err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, namingResourceName, err))
 
 		return
@@ -116,9 +132,9 @@ func (r *NamingResource) Create(ctx context.Context, req resource.CreateRequest,
 	request.SetId(1)
 
 	// Create new Naming
-	response, _, err := r.client.NamingConfigAPI.UpdateNamingConfig(r.auth, strconv.Itoa(int(request.GetId()))).NamingConfigResource(*request).Execute()
+	response, _, err := r.client.NamingConfigAPI.UpdateNamingConfig(ctx, strconv.Itoa(int(request.GetId()))).NamingConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, namingResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, namingResourceName, err)
 
 		return
 	}
@@ -130,8 +146,11 @@ func (r *NamingResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *NamingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var naming *Naming
+	var naming *NamingResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &naming)...)
 
@@ -140,7 +159,7 @@ func (r *NamingResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Get naming current value
-	response, _, err := r.client.NamingConfigAPI.GetNamingConfig(r.auth).Execute()
+	response, _, err := r.client.NamingConfigAPI.GetNamingConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, namingResourceName, err))
 
@@ -154,8 +173,11 @@ func (r *NamingResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *NamingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var naming *Naming
+	var naming *NamingResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &naming)...)
 
@@ -167,9 +189,9 @@ func (r *NamingResource) Update(ctx context.Context, req resource.UpdateRequest,
 	request := naming.read()
 
 	// Update Naming
-	response, _, err := r.client.NamingConfigAPI.UpdateNamingConfig(r.auth, strconv.Itoa(int(request.GetId()))).NamingConfigResource(*request).Execute()
+	response, _, err := r.client.NamingConfigAPI.UpdateNamingConfig(ctx, strconv.Itoa(int(request.GetId()))).NamingConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, namingResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, namingResourceName, err)
 
 		return
 	}
@@ -15,6 +15,18 @@ func TestAccNamingResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid format
+			{
+				Config: `
+				resource "radarr_naming" "test" {
+					rename_movies = true
+					replace_illegal_characters = false
+					colon_replacement_format = "dash"
+					standard_movie_format = "{Movie Title} ({Release Year}) {Quality Full}"
+					movie_folder_format = "{Movie Title} {Quality Fulll}"
+				}`,
+				ExpectError: regexp.MustCompile("unknown token"),
+			},
 			// Unauthorized Create
 			{
 				Config:      testAccNamingResourceConfig("spaceDash") + testUnauthorizedProvider,
@@ -28,6 +28,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationAppriseResource{}
 	_ resource.ResourceWithImportState = &NotificationAppriseResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationAppriseResource{}
 )
 
 func NewNotificationAppriseResource() resource.Resource {
@@ -37,13 +38,14 @@ func NewNotificationAppriseResource() resource.Resource {
 // NotificationAppriseResource defines the notification implementation.
 type NotificationAppriseResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationApprise describes the notification data model.
 type NotificationApprise struct {
 	Tags                        types.Set    `tfsdk:"tags"`
 	FieldTags                   types.Set    `tfsdk:"field_tags"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	Name                        types.String `tfsdk:"name"`
 	StatelessURLs               types.String `tfsdk:"stateless_urls"`
 	ServerURL                   types.String `tfsdk:"server_url"`
@@ -128,6 +130,7 @@ func (r *NotificationAppriseResource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Apprise resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Apprise](https://wiki.servarr.com/radarr/supported#apprise).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -256,7 +259,14 @@ func (r *NotificationAppriseResource) Configure(ctx context.Context, req resourc
 	}
 }
 
+func (r *NotificationAppriseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationApprise
 
@@ -269,9 +279,9 @@ func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.C
 	// Create new NotificationApprise
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationAppriseResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationAppriseResourceName, err)
 
 		return
 	}
@@ -283,6 +293,9 @@ func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.C
 }
 
 func (r *NotificationAppriseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationApprise
 
@@ -293,8 +306,12 @@ func (r *NotificationAppriseResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get NotificationApprise current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationAppriseResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationAppriseResourceName, err))
 
 		return
@@ -307,6 +324,9 @@ func (r *NotificationAppriseResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *NotificationAppriseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationApprise
 
@@ -319,9 +339,9 @@ func (r *NotificationAppriseResource) Update(ctx context.Context, req resource.U
 	// Update NotificationApprise
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationAppriseResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationAppriseResourceName, err)
 
 		return
 	}
@@ -333,6 +353,9 @@ func (r *NotificationAppriseResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *NotificationAppriseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -342,7 +365,7 @@ func (r *NotificationAppriseResource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Delete NotificationApprise current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationAppriseResourceName, err))
 
@@ -354,7 +377,7 @@ func (r *NotificationAppriseResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationAppriseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationAppriseImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationAppriseResourceName+": "+req.ID)
 }
 
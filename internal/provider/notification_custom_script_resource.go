@@ -26,6 +26,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationCustomScriptResource{}
 	_ resource.ResourceWithImportState = &NotificationCustomScriptResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationCustomScriptResource{}
 )
 
 func NewNotificationCustomScriptResource() resource.Resource {
@@ -35,12 +36,13 @@ func NewNotificationCustomScriptResource() resource.Resource {
 // NotificationCustomScriptResource defines the notification implementation.
 type NotificationCustomScriptResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationCustomScript describes the notification data model.
 type NotificationCustomScript struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	Arguments                   types.String `tfsdk:"arguments"`
 	Path                        types.String `tfsdk:"path"`
 	Name                        types.String `tfsdk:"name"`
@@ -114,6 +116,7 @@ func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Custom Script resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Custom Script](https://wiki.servarr.com/radarr/supported#customscript).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -216,7 +219,14 @@ func (r *NotificationCustomScriptResource) Configure(ctx context.Context, req re
 	}
 }
 
+func (r *NotificationCustomScriptResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationCustomScript
 
@@ -229,9 +239,9 @@ func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resou
 	// Create new NotificationCustomScript
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationCustomScriptResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationCustomScriptResourceName, err)
 
 		return
 	}
@@ -243,6 +253,9 @@ func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resou
 }
 
 func (r *NotificationCustomScriptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationCustomScript
 
@@ -253,8 +266,12 @@ func (r *NotificationCustomScriptResource) Read(ctx context.Context, req resourc
 	}
 
 	// Get NotificationCustomScript current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationCustomScriptResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationCustomScriptResourceName, err))
 
 		return
@@ -267,6 +284,9 @@ func (r *NotificationCustomScriptResource) Read(ctx context.Context, req resourc
 }
 
 func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationCustomScript
 
@@ -279,9 +299,9 @@ func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resou
 	// Update NotificationCustomScript
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationCustomScriptResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationCustomScriptResourceName, err)
 
 		return
 	}
@@ -293,6 +313,9 @@ func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resou
 }
 
 func (r *NotificationCustomScriptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -302,7 +325,7 @@ func (r *NotificationCustomScriptResource) Delete(ctx context.Context, req resou
 	}
 
 	// Delete NotificationCustomScript current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationCustomScriptResourceName, err))
 
@@ -314,7 +337,7 @@ func (r *NotificationCustomScriptResource) Delete(ctx context.Context, req resou
 }
 
 func (r *NotificationCustomScriptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationCustomScriptImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationCustomScriptResourceName+": "+req.ID)
 }
 
@@ -24,7 +24,7 @@ func NewNotificationDataSource() datasource.DataSource {
 // NotificationDataSource defines the notification implementation.
 type NotificationDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *NotificationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -423,6 +423,8 @@ func (d *NotificationDataSource) Configure(ctx context.Context, req datasource.C
 }
 
 func (d *NotificationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	var data *Notification
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -431,7 +433,7 @@ func (d *NotificationDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 	// Get notification current value
-	response, _, err := d.client.NotificationAPI.ListNotification(d.auth).Execute()
+	response, _, err := d.client.NotificationAPI.ListNotification(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationDataSourceName, err))
 
@@ -26,6 +26,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationDiscordResource{}
 	_ resource.ResourceWithImportState = &NotificationDiscordResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationDiscordResource{}
 )
 
 func NewNotificationDiscordResource() resource.Resource {
@@ -35,7 +36,7 @@ func NewNotificationDiscordResource() resource.Resource {
 // NotificationDiscordResource defines the notification implementation.
 type NotificationDiscordResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationDiscord describes the notification data model.
@@ -43,6 +44,7 @@ type NotificationDiscord struct {
 	Tags                        types.Set    `tfsdk:"tags"`
 	ImportFields                types.Set    `tfsdk:"import_fields"`
 	GrabFields                  types.Set    `tfsdk:"grab_fields"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	WebHookURL                  types.String `tfsdk:"web_hook_url"`
 	Name                        types.String `tfsdk:"name"`
 	Username                    types.String `tfsdk:"username"`
@@ -123,6 +125,7 @@ func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Discord resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Discord](https://wiki.servarr.com/radarr/supported#discord).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -242,7 +245,14 @@ func (r *NotificationDiscordResource) Configure(ctx context.Context, req resourc
 	}
 }
 
+func (r *NotificationDiscordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationDiscord
 
@@ -255,9 +265,9 @@ func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.C
 	// Create new NotificationDiscord
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationDiscordResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationDiscordResourceName, err)
 
 		return
 	}
@@ -269,6 +279,9 @@ func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.C
 }
 
 func (r *NotificationDiscordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationDiscord
 
@@ -279,8 +292,12 @@ func (r *NotificationDiscordResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get NotificationDiscord current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationDiscordResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationDiscordResourceName, err))
 
 		return
@@ -293,6 +310,9 @@ func (r *NotificationDiscordResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *NotificationDiscordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationDiscord
 
@@ -305,9 +325,9 @@ func (r *NotificationDiscordResource) Update(ctx context.Context, req resource.U
 	// Update NotificationDiscord
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationDiscordResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationDiscordResourceName, err)
 
 		return
 	}
@@ -319,6 +339,9 @@ func (r *NotificationDiscordResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *NotificationDiscordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -328,7 +351,7 @@ func (r *NotificationDiscordResource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Delete NotificationDiscord current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationDiscordResourceName, err))
 
@@ -340,7 +363,7 @@ func (r *NotificationDiscordResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationDiscordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationDiscordImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationDiscordResourceName+": "+req.ID)
 }
 
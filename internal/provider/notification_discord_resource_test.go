@@ -46,6 +46,13 @@ func TestAccNotificationDiscordResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "radarr_notification_discord.test",
+				ImportStateId:     "name=resourceDiscordTest",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
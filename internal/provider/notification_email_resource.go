@@ -28,6 +28,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationEmailResource{}
 	_ resource.ResourceWithImportState = &NotificationEmailResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationEmailResource{}
 )
 
 func NewNotificationEmailResource() resource.Resource {
@@ -37,7 +38,7 @@ func NewNotificationEmailResource() resource.Resource {
 // NotificationEmailResource defines the notification implementation.
 type NotificationEmailResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationEmail describes the notification data model.
@@ -46,6 +47,7 @@ type NotificationEmail struct {
 	To                          types.Set    `tfsdk:"to"`
 	Cc                          types.Set    `tfsdk:"cc"`
 	Bcc                         types.Set    `tfsdk:"bcc"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	From                        types.String `tfsdk:"from"`
 	Server                      types.String `tfsdk:"server"`
 	Name                        types.String `tfsdk:"name"`
@@ -134,6 +136,7 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Email resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Email](https://wiki.servarr.com/radarr/supported#email).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -271,7 +274,14 @@ func (r *NotificationEmailResource) Configure(ctx context.Context, req resource.
 	}
 }
 
+func (r *NotificationEmailResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationEmailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationEmail
 
@@ -284,9 +294,9 @@ func (r *NotificationEmailResource) Create(ctx context.Context, req resource.Cre
 	// Create new NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmailResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationEmailResourceName, err)
 
 		return
 	}
@@ -298,6 +308,9 @@ func (r *NotificationEmailResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *NotificationEmailResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationEmail
 
@@ -308,8 +321,12 @@ func (r *NotificationEmailResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get NotificationEmail current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationEmailResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationEmailResourceName, err))
 
 		return
@@ -322,6 +339,9 @@ func (r *NotificationEmailResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *NotificationEmailResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationEmail
 
@@ -334,9 +354,9 @@ func (r *NotificationEmailResource) Update(ctx context.Context, req resource.Upd
 	// Update NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmailResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationEmailResourceName, err)
 
 		return
 	}
@@ -348,6 +368,9 @@ func (r *NotificationEmailResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *NotificationEmailResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -357,7 +380,7 @@ func (r *NotificationEmailResource) Delete(ctx context.Context, req resource.Del
 	}
 
 	// Delete NotificationEmail current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationEmailResourceName, err))
 
@@ -369,7 +392,7 @@ func (r *NotificationEmailResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *NotificationEmailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationEmailImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationEmailResourceName+": "+req.ID)
 }
 
@@ -35,12 +35,13 @@ func NewNotificationEmbyResource() resource.Resource {
 // NotificationEmbyResource defines the notification implementation.
 type NotificationEmbyResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationEmby describes the notification data model.
 type NotificationEmby struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	Host                        types.String `tfsdk:"host"`
 	APIKey                      types.String `tfsdk:"api_key"`
 	Name                        types.String `tfsdk:"name"`
@@ -123,6 +124,7 @@ func (r *NotificationEmbyResource) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Emby resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Emby](https://wiki.servarr.com/radarr/supported#mediabrowser).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -241,6 +243,9 @@ func (r *NotificationEmbyResource) Configure(ctx context.Context, req resource.C
 }
 
 func (r *NotificationEmbyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationEmby
 
@@ -253,9 +258,9 @@ func (r *NotificationEmbyResource) Create(ctx context.Context, req resource.Crea
 	// Create new NotificationEmby
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmbyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationEmbyResourceName, err)
 
 		return
 	}
@@ -267,6 +272,9 @@ func (r *NotificationEmbyResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *NotificationEmbyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationEmby
 
@@ -277,8 +285,12 @@ func (r *NotificationEmbyResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get NotificationEmby current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationEmbyResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationEmbyResourceName, err))
 
 		return
@@ -291,6 +303,9 @@ func (r *NotificationEmbyResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *NotificationEmbyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationEmby
 
@@ -303,9 +318,9 @@ func (r *NotificationEmbyResource) Update(ctx context.Context, req resource.Upda
 	// Update NotificationEmby
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmbyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationEmbyResourceName, err)
 
 		return
 	}
@@ -317,6 +332,9 @@ func (r *NotificationEmbyResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *NotificationEmbyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -326,7 +344,7 @@ func (r *NotificationEmbyResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	// Delete NotificationEmby current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationEmbyResourceName, err))
 
@@ -338,7 +356,7 @@ func (r *NotificationEmbyResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationEmbyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationEmbyImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationEmbyResourceName+": "+req.ID)
 }
 
@@ -28,6 +28,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationGotifyResource{}
 	_ resource.ResourceWithImportState = &NotificationGotifyResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationGotifyResource{}
 )
 
 func NewNotificationGotifyResource() resource.Resource {
@@ -37,12 +38,13 @@ func NewNotificationGotifyResource() resource.Resource {
 // NotificationGotifyResource defines the notification implementation.
 type NotificationGotifyResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationGotify describes the notification data model.
 type NotificationGotify struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	Server                      types.String `tfsdk:"server"`
 	Name                        types.String `tfsdk:"name"`
 	AppToken                    types.String `tfsdk:"app_token"`
@@ -116,6 +118,7 @@ func (r *NotificationGotifyResource) Schema(_ context.Context, _ resource.Schema
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Gotify resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Gotify](https://wiki.servarr.com/radarr/supported#gotify).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -221,7 +224,14 @@ func (r *NotificationGotifyResource) Configure(ctx context.Context, req resource
 	}
 }
 
+func (r *NotificationGotifyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationGotifyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationGotify
 
@@ -234,9 +244,9 @@ func (r *NotificationGotifyResource) Create(ctx context.Context, req resource.Cr
 	// Create new NotificationGotify
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationGotifyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationGotifyResourceName, err)
 
 		return
 	}
@@ -248,6 +258,9 @@ func (r *NotificationGotifyResource) Create(ctx context.Context, req resource.Cr
 }
 
 func (r *NotificationGotifyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationGotify
 
@@ -258,8 +271,12 @@ func (r *NotificationGotifyResource) Read(ctx context.Context, req resource.Read
 	}
 
 	// Get NotificationGotify current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationGotifyResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationGotifyResourceName, err))
 
 		return
@@ -272,6 +289,9 @@ func (r *NotificationGotifyResource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *NotificationGotifyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationGotify
 
@@ -284,9 +304,9 @@ func (r *NotificationGotifyResource) Update(ctx context.Context, req resource.Up
 	// Update NotificationGotify
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationGotifyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationGotifyResourceName, err)
 
 		return
 	}
@@ -298,6 +318,9 @@ func (r *NotificationGotifyResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *NotificationGotifyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -307,7 +330,7 @@ func (r *NotificationGotifyResource) Delete(ctx context.Context, req resource.De
 	}
 
 	// Delete NotificationGotify current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationGotifyResourceName, err))
 
@@ -319,7 +342,7 @@ func (r *NotificationGotifyResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *NotificationGotifyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationGotifyImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationGotifyResourceName+": "+req.ID)
 }
 
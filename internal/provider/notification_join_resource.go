@@ -28,6 +28,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationJoinResource{}
 	_ resource.ResourceWithImportState = &NotificationJoinResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationJoinResource{}
 )
 
 func NewNotificationJoinResource() resource.Resource {
@@ -37,12 +38,13 @@ func NewNotificationJoinResource() resource.Resource {
 // NotificationJoinResource defines the notification implementation.
 type NotificationJoinResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationJoin describes the notification data model.
 type NotificationJoin struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	DeviceNames                 types.String `tfsdk:"device_names"`
 	Name                        types.String `tfsdk:"name"`
 	APIKey                      types.String `tfsdk:"api_key"`
@@ -116,6 +118,7 @@ func (r *NotificationJoinResource) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Join resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Join](https://wiki.servarr.com/radarr/supported#join).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -221,7 +224,14 @@ func (r *NotificationJoinResource) Configure(ctx context.Context, req resource.C
 	}
 }
 
+func (r *NotificationJoinResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationJoinResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationJoin
 
@@ -234,9 +244,9 @@ func (r *NotificationJoinResource) Create(ctx context.Context, req resource.Crea
 	// Create new NotificationJoin
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationJoinResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationJoinResourceName, err)
 
 		return
 	}
@@ -248,6 +258,9 @@ func (r *NotificationJoinResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *NotificationJoinResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationJoin
 
@@ -258,8 +271,12 @@ func (r *NotificationJoinResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get NotificationJoin current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationJoinResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationJoinResourceName, err))
 
 		return
@@ -272,6 +289,9 @@ func (r *NotificationJoinResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *NotificationJoinResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationJoin
 
@@ -284,9 +304,9 @@ func (r *NotificationJoinResource) Update(ctx context.Context, req resource.Upda
 	// Update NotificationJoin
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationJoinResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationJoinResourceName, err)
 
 		return
 	}
@@ -298,6 +318,9 @@ func (r *NotificationJoinResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *NotificationJoinResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -307,7 +330,7 @@ func (r *NotificationJoinResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	// Delete NotificationJoin current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationJoinResourceName, err))
 
@@ -319,7 +342,7 @@ func (r *NotificationJoinResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationJoinResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationJoinImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationJoinResourceName+": "+req.ID)
 }
 
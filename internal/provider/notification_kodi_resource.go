@@ -26,6 +26,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationKodiResource{}
 	_ resource.ResourceWithImportState = &NotificationKodiResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationKodiResource{}
 )
 
 func NewNotificationKodiResource() resource.Resource {
@@ -35,12 +36,13 @@ func NewNotificationKodiResource() resource.Resource {
 // NotificationKodiResource defines the notification implementation.
 type NotificationKodiResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationKodi describes the notification data model.
 type NotificationKodi struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	Host                        types.String `tfsdk:"host"`
 	Name                        types.String `tfsdk:"name"`
 	Username                    types.String `tfsdk:"username"`
@@ -138,6 +140,7 @@ func (r *NotificationKodiResource) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Kodi resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Kodi](https://wiki.servarr.com/radarr/supported#xbmc).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -280,7 +283,14 @@ func (r *NotificationKodiResource) Configure(ctx context.Context, req resource.C
 	}
 }
 
+func (r *NotificationKodiResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationKodiResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationKodi
 
@@ -293,9 +303,9 @@ func (r *NotificationKodiResource) Create(ctx context.Context, req resource.Crea
 	// Create new NotificationKodi
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationKodiResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationKodiResourceName, err)
 
 		return
 	}
@@ -307,6 +317,9 @@ func (r *NotificationKodiResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *NotificationKodiResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationKodi
 
@@ -317,8 +330,12 @@ func (r *NotificationKodiResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get NotificationKodi current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationKodiResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationKodiResourceName, err))
 
 		return
@@ -331,6 +348,9 @@ func (r *NotificationKodiResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *NotificationKodiResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationKodi
 
@@ -343,9 +363,9 @@ func (r *NotificationKodiResource) Update(ctx context.Context, req resource.Upda
 	// Update NotificationKodi
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationKodiResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationKodiResourceName, err)
 
 		return
 	}
@@ -357,6 +377,9 @@ func (r *NotificationKodiResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *NotificationKodiResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -366,7 +389,7 @@ func (r *NotificationKodiResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	// Delete NotificationKodi current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationKodiResourceName, err))
 
@@ -378,7 +401,7 @@ func (r *NotificationKodiResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationKodiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationKodiImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationKodiResourceName+": "+req.ID)
 }
 
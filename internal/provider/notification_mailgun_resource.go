@@ -26,6 +26,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationMailgunResource{}
 	_ resource.ResourceWithImportState = &NotificationMailgunResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationMailgunResource{}
 )
 
 func NewNotificationMailgunResource() resource.Resource {
@@ -35,13 +36,14 @@ func NewNotificationMailgunResource() resource.Resource {
 // NotificationMailgunResource defines the notification implementation.
 type NotificationMailgunResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationMailgun describes the notification data model.
 type NotificationMailgun struct {
 	Tags                        types.Set    `tfsdk:"tags"`
 	Recipients                  types.Set    `tfsdk:"recipients"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	From                        types.String `tfsdk:"from"`
 	SenderDomain                types.String `tfsdk:"sender_domain"`
 	Name                        types.String `tfsdk:"name"`
@@ -120,6 +122,7 @@ func (r *NotificationMailgunResource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Mailgun resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Mailgun](https://wiki.servarr.com/radarr/supported#mailgun).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -233,7 +236,14 @@ func (r *NotificationMailgunResource) Configure(ctx context.Context, req resourc
 	}
 }
 
+func (r *NotificationMailgunResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationMailgunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationMailgun
 
@@ -246,9 +256,9 @@ func (r *NotificationMailgunResource) Create(ctx context.Context, req resource.C
 	// Create new NotificationMailgun
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationMailgunResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationMailgunResourceName, err)
 
 		return
 	}
@@ -260,6 +270,9 @@ func (r *NotificationMailgunResource) Create(ctx context.Context, req resource.C
 }
 
 func (r *NotificationMailgunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationMailgun
 
@@ -270,8 +283,12 @@ func (r *NotificationMailgunResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get NotificationMailgun current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationMailgunResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationMailgunResourceName, err))
 
 		return
@@ -284,6 +301,9 @@ func (r *NotificationMailgunResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *NotificationMailgunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationMailgun
 
@@ -296,9 +316,9 @@ func (r *NotificationMailgunResource) Update(ctx context.Context, req resource.U
 	// Update NotificationMailgun
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationMailgunResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationMailgunResourceName, err)
 
 		return
 	}
@@ -310,6 +330,9 @@ func (r *NotificationMailgunResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *NotificationMailgunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -319,7 +342,7 @@ func (r *NotificationMailgunResource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Delete NotificationMailgun current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationMailgunResourceName, err))
 
@@ -331,7 +354,7 @@ func (r *NotificationMailgunResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationMailgunResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationMailgunImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationMailgunResourceName+": "+req.ID)
 }
 
@@ -26,6 +26,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationNotifiarrResource{}
 	_ resource.ResourceWithImportState = &NotificationNotifiarrResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationNotifiarrResource{}
 )
 
 func NewNotificationNotifiarrResource() resource.Resource {
@@ -35,12 +36,13 @@ func NewNotificationNotifiarrResource() resource.Resource {
 // NotificationNotifiarrResource defines the notification implementation.
 type NotificationNotifiarrResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationNotifiarr describes the notification data model.
 type NotificationNotifiarr struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	Name                        types.String `tfsdk:"name"`
 	APIKey                      types.String `tfsdk:"api_key"`
 	ID                          types.Int64  `tfsdk:"id"`
@@ -108,6 +110,7 @@ func (r *NotificationNotifiarrResource) Schema(_ context.Context, _ resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Notifiarr resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Notifiarr](https://wiki.servarr.com/radarr/supported#notifiarr).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -201,7 +204,14 @@ func (r *NotificationNotifiarrResource) Configure(ctx context.Context, req resou
 	}
 }
 
+func (r *NotificationNotifiarrResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationNotifiarrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationNotifiarr
 
@@ -214,9 +224,9 @@ func (r *NotificationNotifiarrResource) Create(ctx context.Context, req resource
 	// Create new NotificationNotifiarr
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationNotifiarrResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationNotifiarrResourceName, err)
 
 		return
 	}
@@ -228,6 +238,9 @@ func (r *NotificationNotifiarrResource) Create(ctx context.Context, req resource
 }
 
 func (r *NotificationNotifiarrResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationNotifiarr
 
@@ -238,8 +251,12 @@ func (r *NotificationNotifiarrResource) Read(ctx context.Context, req resource.R
 	}
 
 	// Get NotificationNotifiarr current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationNotifiarrResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationNotifiarrResourceName, err))
 
 		return
@@ -252,6 +269,9 @@ func (r *NotificationNotifiarrResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *NotificationNotifiarrResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationNotifiarr
 
@@ -264,9 +284,9 @@ func (r *NotificationNotifiarrResource) Update(ctx context.Context, req resource
 	// Update NotificationNotifiarr
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationNotifiarrResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationNotifiarrResourceName, err)
 
 		return
 	}
@@ -278,6 +298,9 @@ func (r *NotificationNotifiarrResource) Update(ctx context.Context, req resource
 }
 
 func (r *NotificationNotifiarrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -287,7 +310,7 @@ func (r *NotificationNotifiarrResource) Delete(ctx context.Context, req resource
 	}
 
 	// Delete NotificationNotifiarr current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationNotifiarrResourceName, err))
 
@@ -299,7 +322,7 @@ func (r *NotificationNotifiarrResource) Delete(ctx context.Context, req resource
 }
 
 func (r *NotificationNotifiarrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationNotifiarrImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationNotifiarrResourceName+": "+req.ID)
 }
 
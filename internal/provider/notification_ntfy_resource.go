@@ -28,6 +28,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationNtfyResource{}
 	_ resource.ResourceWithImportState = &NotificationNtfyResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationNtfyResource{}
 )
 
 func NewNotificationNtfyResource() resource.Resource {
@@ -37,7 +38,7 @@ func NewNotificationNtfyResource() resource.Resource {
 // NotificationNtfyResource defines the notification implementation.
 type NotificationNtfyResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationNtfy describes the notification data model.
@@ -45,6 +46,7 @@ type NotificationNtfy struct {
 	Tags                        types.Set    `tfsdk:"tags"`
 	FieldTags                   types.Set    `tfsdk:"field_tags"`
 	Topics                      types.Set    `tfsdk:"topics"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	ClickURL                    types.String `tfsdk:"click_url"`
 	ServerURL                   types.String `tfsdk:"server_url"`
 	Username                    types.String `tfsdk:"username"`
@@ -131,6 +133,7 @@ func (r *NotificationNtfyResource) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Ntfy resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Ntfy](https://wiki.servarr.com/radarr/supported#ntfy).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -265,7 +268,14 @@ func (r *NotificationNtfyResource) Configure(ctx context.Context, req resource.C
 	}
 }
 
+func (r *NotificationNtfyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationNtfyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationNtfy
 
@@ -278,9 +288,9 @@ func (r *NotificationNtfyResource) Create(ctx context.Context, req resource.Crea
 	// Create new NotificationNtfy
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationNtfyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationNtfyResourceName, err)
 
 		return
 	}
@@ -292,6 +302,9 @@ func (r *NotificationNtfyResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *NotificationNtfyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationNtfy
 
@@ -302,8 +315,12 @@ func (r *NotificationNtfyResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get NotificationNtfy current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationNtfyResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationNtfyResourceName, err))
 
 		return
@@ -316,6 +333,9 @@ func (r *NotificationNtfyResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *NotificationNtfyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationNtfy
 
@@ -328,9 +348,9 @@ func (r *NotificationNtfyResource) Update(ctx context.Context, req resource.Upda
 	// Update NotificationNtfy
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationNtfyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationNtfyResourceName, err)
 
 		return
 	}
@@ -342,6 +362,9 @@ func (r *NotificationNtfyResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *NotificationNtfyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -351,7 +374,7 @@ func (r *NotificationNtfyResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	// Delete NotificationNtfy current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationNtfyResourceName, err))
 
@@ -363,7 +386,7 @@ func (r *NotificationNtfyResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationNtfyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationNtfyImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationNtfyResourceName+": "+req.ID)
 }
 
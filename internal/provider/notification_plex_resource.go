@@ -35,12 +35,13 @@ func NewNotificationPlexResource() resource.Resource {
 // NotificationPlexResource defines the notification implementation.
 type NotificationPlexResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationPlex describes the notification data model.
 type NotificationPlex struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	Host                        types.String `tfsdk:"host"`
 	AuthToken                   types.String `tfsdk:"auth_token"`
 	Name                        types.String `tfsdk:"name"`
@@ -108,6 +109,7 @@ func (r *NotificationPlexResource) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Plex resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Plex](https://wiki.servarr.com/radarr/supported#plexserver).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_download": schema.BoolAttribute{
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
@@ -201,6 +203,9 @@ func (r *NotificationPlexResource) Configure(ctx context.Context, req resource.C
 }
 
 func (r *NotificationPlexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationPlex
 
@@ -213,9 +218,9 @@ func (r *NotificationPlexResource) Create(ctx context.Context, req resource.Crea
 	// Create new NotificationPlex
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationPlexResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationPlexResourceName, err)
 
 		return
 	}
@@ -227,6 +232,9 @@ func (r *NotificationPlexResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *NotificationPlexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationPlex
 
@@ -237,8 +245,12 @@ func (r *NotificationPlexResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get NotificationPlex current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationPlexResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationPlexResourceName, err))
 
 		return
@@ -251,6 +263,9 @@ func (r *NotificationPlexResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *NotificationPlexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationPlex
 
@@ -263,9 +278,9 @@ func (r *NotificationPlexResource) Update(ctx context.Context, req resource.Upda
 	// Update NotificationPlex
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationPlexResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationPlexResourceName, err)
 
 		return
 	}
@@ -277,6 +292,9 @@ func (r *NotificationPlexResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *NotificationPlexResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -286,7 +304,7 @@ func (r *NotificationPlexResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	// Delete NotificationPlex current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationPlexResourceName, err))
 
@@ -298,7 +316,7 @@ func (r *NotificationPlexResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationPlexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationPlexImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationPlexResourceName+": "+req.ID)
 }
 
@@ -28,6 +28,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationProwlResource{}
 	_ resource.ResourceWithImportState = &NotificationProwlResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationProwlResource{}
 )
 
 func NewNotificationProwlResource() resource.Resource {
@@ -37,12 +38,13 @@ func NewNotificationProwlResource() resource.Resource {
 // NotificationProwlResource defines the notification implementation.
 type NotificationProwlResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationProwl describes the notification data model.
 type NotificationProwl struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	Name                        types.String `tfsdk:"name"`
 	APIKey                      types.String `tfsdk:"api_key"`
 	Priority                    types.Int64  `tfsdk:"priority"`
@@ -113,6 +115,7 @@ func (r *NotificationProwlResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Prowl resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Prowl](https://wiki.servarr.com/radarr/supported#prowl).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -214,7 +217,14 @@ func (r *NotificationProwlResource) Configure(ctx context.Context, req resource.
 	}
 }
 
+func (r *NotificationProwlResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationProwlResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationProwl
 
@@ -227,9 +237,9 @@ func (r *NotificationProwlResource) Create(ctx context.Context, req resource.Cre
 	// Create new NotificationProwl
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationProwlResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationProwlResourceName, err)
 
 		return
 	}
@@ -241,6 +251,9 @@ func (r *NotificationProwlResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *NotificationProwlResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationProwl
 
@@ -251,8 +264,12 @@ func (r *NotificationProwlResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get NotificationProwl current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationProwlResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationProwlResourceName, err))
 
 		return
@@ -265,6 +282,9 @@ func (r *NotificationProwlResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *NotificationProwlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationProwl
 
@@ -277,9 +297,9 @@ func (r *NotificationProwlResource) Update(ctx context.Context, req resource.Upd
 	// Update NotificationProwl
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationProwlResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationProwlResourceName, err)
 
 		return
 	}
@@ -291,6 +311,9 @@ func (r *NotificationProwlResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *NotificationProwlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -300,7 +323,7 @@ func (r *NotificationProwlResource) Delete(ctx context.Context, req resource.Del
 	}
 
 	// Delete NotificationProwl current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationProwlResourceName, err))
 
@@ -312,7 +335,7 @@ func (r *NotificationProwlResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *NotificationProwlResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationProwlImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationProwlResourceName+": "+req.ID)
 }
 
@@ -26,6 +26,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationPushbulletResource{}
 	_ resource.ResourceWithImportState = &NotificationPushbulletResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationPushbulletResource{}
 )
 
 func NewNotificationPushbulletResource() resource.Resource {
@@ -35,7 +36,7 @@ func NewNotificationPushbulletResource() resource.Resource {
 // NotificationPushbulletResource defines the notification implementation.
 type NotificationPushbulletResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationPushbullet describes the notification data model.
@@ -43,6 +44,7 @@ type NotificationPushbullet struct {
 	Tags                        types.Set    `tfsdk:"tags"`
 	DeviceIDs                   types.Set    `tfsdk:"device_ids"`
 	ChannelTags                 types.Set    `tfsdk:"channel_tags"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	SenderID                    types.String `tfsdk:"sender_id"`
 	Name                        types.String `tfsdk:"name"`
 	APIKey                      types.String `tfsdk:"api_key"`
@@ -117,6 +119,7 @@ func (r *NotificationPushbulletResource) Schema(_ context.Context, _ resource.Sc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Pushbullet resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Pushbullet](https://wiki.servarr.com/radarr/supported#pushbullet).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -227,7 +230,14 @@ func (r *NotificationPushbulletResource) Configure(ctx context.Context, req reso
 	}
 }
 
+func (r *NotificationPushbulletResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationPushbulletResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationPushbullet
 
@@ -240,9 +250,9 @@ func (r *NotificationPushbulletResource) Create(ctx context.Context, req resourc
 	// Create new NotificationPushbullet
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationPushbulletResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationPushbulletResourceName, err)
 
 		return
 	}
@@ -254,6 +264,9 @@ func (r *NotificationPushbulletResource) Create(ctx context.Context, req resourc
 }
 
 func (r *NotificationPushbulletResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationPushbullet
 
@@ -264,8 +277,12 @@ func (r *NotificationPushbulletResource) Read(ctx context.Context, req resource.
 	}
 
 	// Get NotificationPushbullet current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationPushbulletResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationPushbulletResourceName, err))
 
 		return
@@ -278,6 +295,9 @@ func (r *NotificationPushbulletResource) Read(ctx context.Context, req resource.
 }
 
 func (r *NotificationPushbulletResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationPushbullet
 
@@ -290,9 +310,9 @@ func (r *NotificationPushbulletResource) Update(ctx context.Context, req resourc
 	// Update NotificationPushbullet
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationPushbulletResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationPushbulletResourceName, err)
 
 		return
 	}
@@ -304,6 +324,9 @@ func (r *NotificationPushbulletResource) Update(ctx context.Context, req resourc
 }
 
 func (r *NotificationPushbulletResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -313,7 +336,7 @@ func (r *NotificationPushbulletResource) Delete(ctx context.Context, req resourc
 	}
 
 	// Delete NotificationPushbullet current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationPushbulletResourceName, err))
 
@@ -325,7 +348,7 @@ func (r *NotificationPushbulletResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *NotificationPushbulletResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationPushbulletImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationPushbulletResourceName+": "+req.ID)
 }
 
@@ -28,6 +28,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationPushoverResource{}
 	_ resource.ResourceWithImportState = &NotificationPushoverResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationPushoverResource{}
 )
 
 func NewNotificationPushoverResource() resource.Resource {
@@ -37,13 +38,14 @@ func NewNotificationPushoverResource() resource.Resource {
 // NotificationPushoverResource defines the notification implementation.
 type NotificationPushoverResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationPushover describes the notification data model.
 type NotificationPushover struct {
 	Tags                        types.Set    `tfsdk:"tags"`
 	Devices                     types.Set    `tfsdk:"devices"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	Sound                       types.String `tfsdk:"sound"`
 	Name                        types.String `tfsdk:"name"`
 	APIKey                      types.String `tfsdk:"api_key"`
@@ -128,6 +130,7 @@ func (r *NotificationPushoverResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Pushover resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Pushover](https://wiki.servarr.com/radarr/supported#pushover).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -255,7 +258,14 @@ func (r *NotificationPushoverResource) Configure(ctx context.Context, req resour
 	}
 }
 
+func (r *NotificationPushoverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationPushoverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationPushover
 
@@ -268,9 +278,9 @@ func (r *NotificationPushoverResource) Create(ctx context.Context, req resource.
 	// Create new NotificationPushover
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationPushoverResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationPushoverResourceName, err)
 
 		return
 	}
@@ -282,6 +292,9 @@ func (r *NotificationPushoverResource) Create(ctx context.Context, req resource.
 }
 
 func (r *NotificationPushoverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationPushover
 
@@ -292,8 +305,12 @@ func (r *NotificationPushoverResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get NotificationPushover current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationPushoverResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationPushoverResourceName, err))
 
 		return
@@ -306,6 +323,9 @@ func (r *NotificationPushoverResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *NotificationPushoverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationPushover
 
@@ -318,9 +338,9 @@ func (r *NotificationPushoverResource) Update(ctx context.Context, req resource.
 	// Update NotificationPushover
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationPushoverResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationPushoverResourceName, err)
 
 		return
 	}
@@ -332,6 +352,9 @@ func (r *NotificationPushoverResource) Update(ctx context.Context, req resource.
 }
 
 func (r *NotificationPushoverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -341,7 +364,7 @@ func (r *NotificationPushoverResource) Delete(ctx context.Context, req resource.
 	}
 
 	// Delete NotificationPushover current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationPushoverResourceName, err))
 
@@ -353,7 +376,7 @@ func (r *NotificationPushoverResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *NotificationPushoverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationPushoverImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationPushoverResourceName+": "+req.ID)
 }
 
@@ -25,6 +25,7 @@ const notificationResourceName = "notification"
 var (
 	_ resource.Resource                = &NotificationResource{}
 	_ resource.ResourceWithImportState = &NotificationResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationResource{}
 )
 
 var notificationFields = helpers.Fields{
@@ -43,7 +44,7 @@ func NewNotificationResource() resource.Resource {
 // NotificationResource defines the notification implementation.
 type NotificationResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // Notification describes the notification data model.
@@ -140,6 +141,12 @@ type Notification struct {
 	OnManualInteractionRequired types.Bool   `tfsdk:"on_manual_interaction_required"`
 }
 
+// NotificationResourceModel describes the notification resource data model.
+type NotificationResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	Notification
+}
+
 func (n Notification) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -244,6 +251,7 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -734,9 +742,16 @@ func (r *NotificationResource) Configure(ctx context.Context, req resource.Confi
 	}
 }
 
+func (r *NotificationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var notification *Notification
+	var notification *NotificationResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
 
@@ -747,9 +762,9 @@ func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRe
 	// Create new Notification
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationResourceName, err)
 
 		return
 	}
@@ -757,16 +772,20 @@ func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRe
 	tflog.Trace(ctx, "created "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
+	var state NotificationResourceModel
 
-	state.writeSensitive(notification)
+	state.Timeouts = notification.Timeouts
+	state.writeSensitive(&notification.Notification)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *NotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var notification *Notification
+	var notification *NotificationResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &notification)...)
 
@@ -775,8 +794,12 @@ func (r *NotificationResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get Notification current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationResourceName, err))
 
 		return
@@ -785,16 +808,20 @@ func (r *NotificationResource) Read(ctx context.Context, req resource.ReadReques
 	tflog.Trace(ctx, "read "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
+	var state NotificationResourceModel
 
-	state.writeSensitive(notification)
+	state.Timeouts = notification.Timeouts
+	state.writeSensitive(&notification.Notification)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var notification *Notification
+	var notification *NotificationResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
 
@@ -805,9 +832,9 @@ func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update Notification
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationResourceName, err)
 
 		return
 	}
@@ -815,14 +842,18 @@ func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Trace(ctx, "updated "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
+	var state NotificationResourceModel
 
-	state.writeSensitive(notification)
+	state.Timeouts = notification.Timeouts
+	state.writeSensitive(&notification.Notification)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *NotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -832,7 +863,7 @@ func (r *NotificationResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	// Delete Notification current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationResourceName, err))
 
@@ -844,10 +875,24 @@ func (r *NotificationResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *NotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, ""), req, resp)
 	tflog.Trace(ctx, "imported "+notificationResourceName+": "+req.ID)
 }
 
+// notificationImportLookups resolves the notification import identifiers, only matching the given implementation if set.
+func notificationImportLookups(ctx context.Context, client *radarr.APIClient, implementation string) map[string]helpers.ImportLookup {
+	return map[string]helpers.ImportLookup{
+		"name=": func(name string) (int, error) {
+			notifications, _, err := client.NotificationAPI.ListNotification(ctx).Execute()
+			if err != nil {
+				return 0, err
+			}
+
+			return helpers.FindImportID(helpers.FilterImplementation(notifications, implementation), name, (*radarr.NotificationResource).GetName)
+		},
+	}
+}
+
 func (n *Notification) write(ctx context.Context, notification *radarr.NotificationResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
@@ -26,6 +26,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationSendgridResource{}
 	_ resource.ResourceWithImportState = &NotificationSendgridResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationSendgridResource{}
 )
 
 func NewNotificationSendgridResource() resource.Resource {
@@ -35,13 +36,14 @@ func NewNotificationSendgridResource() resource.Resource {
 // NotificationSendgridResource defines the notification implementation.
 type NotificationSendgridResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationSendgrid describes the notification data model.
 type NotificationSendgrid struct {
 	Tags                        types.Set    `tfsdk:"tags"`
 	Recipients                  types.Set    `tfsdk:"recipients"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	From                        types.String `tfsdk:"from"`
 	Name                        types.String `tfsdk:"name"`
 	APIKey                      types.String `tfsdk:"api_key"`
@@ -114,6 +116,7 @@ func (r *NotificationSendgridResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Sendgrid resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Sendgrid](https://wiki.servarr.com/radarr/supported#sendgrid).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -217,7 +220,14 @@ func (r *NotificationSendgridResource) Configure(ctx context.Context, req resour
 	}
 }
 
+func (r *NotificationSendgridResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationSendgridResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationSendgrid
 
@@ -230,9 +240,9 @@ func (r *NotificationSendgridResource) Create(ctx context.Context, req resource.
 	// Create new NotificationSendgrid
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationSendgridResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationSendgridResourceName, err)
 
 		return
 	}
@@ -244,6 +254,9 @@ func (r *NotificationSendgridResource) Create(ctx context.Context, req resource.
 }
 
 func (r *NotificationSendgridResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationSendgrid
 
@@ -254,8 +267,12 @@ func (r *NotificationSendgridResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get NotificationSendgrid current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationSendgridResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationSendgridResourceName, err))
 
 		return
@@ -268,6 +285,9 @@ func (r *NotificationSendgridResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *NotificationSendgridResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationSendgrid
 
@@ -280,9 +300,9 @@ func (r *NotificationSendgridResource) Update(ctx context.Context, req resource.
 	// Update NotificationSendgrid
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationSendgridResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationSendgridResourceName, err)
 
 		return
 	}
@@ -294,6 +314,9 @@ func (r *NotificationSendgridResource) Update(ctx context.Context, req resource.
 }
 
 func (r *NotificationSendgridResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -303,7 +326,7 @@ func (r *NotificationSendgridResource) Delete(ctx context.Context, req resource.
 	}
 
 	// Delete NotificationSendgrid current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationSendgridResourceName, err))
 
@@ -315,7 +338,7 @@ func (r *NotificationSendgridResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *NotificationSendgridResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationSendgridImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationSendgridResourceName+": "+req.ID)
 }
 
@@ -26,6 +26,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationSimplepushResource{}
 	_ resource.ResourceWithImportState = &NotificationSimplepushResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationSimplepushResource{}
 )
 
 func NewNotificationSimplepushResource() resource.Resource {
@@ -35,12 +36,13 @@ func NewNotificationSimplepushResource() resource.Resource {
 // NotificationSimplepushResource defines the notification implementation.
 type NotificationSimplepushResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationSimplepush describes the notification data model.
 type NotificationSimplepush struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	Event                       types.String `tfsdk:"event"`
 	Name                        types.String `tfsdk:"name"`
 	Key                         types.String `tfsdk:"key"`
@@ -111,6 +113,7 @@ func (r *NotificationSimplepushResource) Schema(_ context.Context, _ resource.Sc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Simplepush resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Simplepush](https://wiki.servarr.com/radarr/supported#simplepush).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -209,7 +212,14 @@ func (r *NotificationSimplepushResource) Configure(ctx context.Context, req reso
 	}
 }
 
+func (r *NotificationSimplepushResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationSimplepushResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationSimplepush
 
@@ -222,9 +232,9 @@ func (r *NotificationSimplepushResource) Create(ctx context.Context, req resourc
 	// Create new NotificationSimplepush
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationSimplepushResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationSimplepushResourceName, err)
 
 		return
 	}
@@ -236,6 +246,9 @@ func (r *NotificationSimplepushResource) Create(ctx context.Context, req resourc
 }
 
 func (r *NotificationSimplepushResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationSimplepush
 
@@ -246,8 +259,12 @@ func (r *NotificationSimplepushResource) Read(ctx context.Context, req resource.
 	}
 
 	// Get NotificationSimplepush current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationSimplepushResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationSimplepushResourceName, err))
 
 		return
@@ -260,6 +277,9 @@ func (r *NotificationSimplepushResource) Read(ctx context.Context, req resource.
 }
 
 func (r *NotificationSimplepushResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationSimplepush
 
@@ -272,9 +292,9 @@ func (r *NotificationSimplepushResource) Update(ctx context.Context, req resourc
 	// Update NotificationSimplepush
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationSimplepushResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationSimplepushResourceName, err)
 
 		return
 	}
@@ -286,6 +306,9 @@ func (r *NotificationSimplepushResource) Update(ctx context.Context, req resourc
 }
 
 func (r *NotificationSimplepushResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -295,7 +318,7 @@ func (r *NotificationSimplepushResource) Delete(ctx context.Context, req resourc
 	}
 
 	// Delete NotificationSimplepush current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationSimplepushResourceName, err))
 
@@ -307,7 +330,7 @@ func (r *NotificationSimplepushResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *NotificationSimplepushResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationSimplepushImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationSimplepushResourceName+": "+req.ID)
 }
 
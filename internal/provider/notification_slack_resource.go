@@ -26,6 +26,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationSlackResource{}
 	_ resource.ResourceWithImportState = &NotificationSlackResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationSlackResource{}
 )
 
 func NewNotificationSlackResource() resource.Resource {
@@ -35,12 +36,13 @@ func NewNotificationSlackResource() resource.Resource {
 // NotificationSlackResource defines the notification implementation.
 type NotificationSlackResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationSlack describes the notification data model.
 type NotificationSlack struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	WebHookURL                  types.String `tfsdk:"web_hook_url"`
 	Name                        types.String `tfsdk:"name"`
 	Username                    types.String `tfsdk:"username"`
@@ -120,6 +122,7 @@ func (r *NotificationSlackResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Slack resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Slack](https://wiki.servarr.com/radarr/supported#slack).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -231,7 +234,14 @@ func (r *NotificationSlackResource) Configure(ctx context.Context, req resource.
 	}
 }
 
+func (r *NotificationSlackResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationSlackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationSlack
 
@@ -244,9 +254,9 @@ func (r *NotificationSlackResource) Create(ctx context.Context, req resource.Cre
 	// Create new NotificationSlack
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationSlackResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationSlackResourceName, err)
 
 		return
 	}
@@ -258,6 +268,9 @@ func (r *NotificationSlackResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *NotificationSlackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationSlack
 
@@ -268,8 +281,12 @@ func (r *NotificationSlackResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get NotificationSlack current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationSlackResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationSlackResourceName, err))
 
 		return
@@ -282,6 +299,9 @@ func (r *NotificationSlackResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *NotificationSlackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationSlack
 
@@ -294,9 +314,9 @@ func (r *NotificationSlackResource) Update(ctx context.Context, req resource.Upd
 	// Update NotificationSlack
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationSlackResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationSlackResourceName, err)
 
 		return
 	}
@@ -308,6 +328,9 @@ func (r *NotificationSlackResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *NotificationSlackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -317,7 +340,7 @@ func (r *NotificationSlackResource) Delete(ctx context.Context, req resource.Del
 	}
 
 	// Delete NotificationSlack current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationSlackResourceName, err))
 
@@ -329,7 +352,7 @@ func (r *NotificationSlackResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *NotificationSlackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationSlackImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationSlackResourceName+": "+req.ID)
 }
 
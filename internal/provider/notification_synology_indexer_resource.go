@@ -35,12 +35,13 @@ func NewNotificationSynologyResource() resource.Resource {
 // NotificationSynologyResource defines the notification implementation.
 type NotificationSynologyResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationSynology describes the notification data model.
 type NotificationSynology struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	Name                        types.String `tfsdk:"name"`
 	ID                          types.Int64  `tfsdk:"id"`
 	UpdateLibrary               types.Bool   `tfsdk:"update_library"`
@@ -96,6 +97,7 @@ func (r *NotificationSynologyResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Synology Indexer resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Synology](https://wiki.servarr.com/radarr/supported#synologyindexer).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_download": schema.BoolAttribute{
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
@@ -170,6 +172,9 @@ func (r *NotificationSynologyResource) Configure(ctx context.Context, req resour
 }
 
 func (r *NotificationSynologyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationSynology
 
@@ -182,9 +187,9 @@ func (r *NotificationSynologyResource) Create(ctx context.Context, req resource.
 	// Create new NotificationSynology
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationSynologyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationSynologyResourceName, err)
 
 		return
 	}
@@ -196,6 +201,9 @@ func (r *NotificationSynologyResource) Create(ctx context.Context, req resource.
 }
 
 func (r *NotificationSynologyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationSynology
 
@@ -206,8 +214,12 @@ func (r *NotificationSynologyResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get NotificationSynology current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationSynologyResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationSynologyResourceName, err))
 
 		return
@@ -220,6 +232,9 @@ func (r *NotificationSynologyResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *NotificationSynologyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationSynology
 
@@ -232,9 +247,9 @@ func (r *NotificationSynologyResource) Update(ctx context.Context, req resource.
 	// Update NotificationSynology
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationSynologyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationSynologyResourceName, err)
 
 		return
 	}
@@ -246,6 +261,9 @@ func (r *NotificationSynologyResource) Update(ctx context.Context, req resource.
 }
 
 func (r *NotificationSynologyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -255,7 +273,7 @@ func (r *NotificationSynologyResource) Delete(ctx context.Context, req resource.
 	}
 
 	// Delete NotificationSynology current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationSynologyResourceName, err))
 
@@ -267,7 +285,7 @@ func (r *NotificationSynologyResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *NotificationSynologyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationSynologyImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationSynologyResourceName+": "+req.ID)
 }
 
@@ -26,6 +26,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationTelegramResource{}
 	_ resource.ResourceWithImportState = &NotificationTelegramResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationTelegramResource{}
 )
 
 func NewNotificationTelegramResource() resource.Resource {
@@ -35,12 +36,13 @@ func NewNotificationTelegramResource() resource.Resource {
 // NotificationTelegramResource defines the notification implementation.
 type NotificationTelegramResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationTelegram describes the notification data model.
 type NotificationTelegram struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	ChatID                      types.String `tfsdk:"chat_id"`
 	TopicID                     types.String `tfsdk:"topic_id"`
 	Name                        types.String `tfsdk:"name"`
@@ -117,6 +119,7 @@ func (r *NotificationTelegramResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Telegram resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Telegram](https://wiki.servarr.com/radarr/supported#telegram).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -224,7 +227,14 @@ func (r *NotificationTelegramResource) Configure(ctx context.Context, req resour
 	}
 }
 
+func (r *NotificationTelegramResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationTelegramResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationTelegram
 
@@ -237,9 +247,9 @@ func (r *NotificationTelegramResource) Create(ctx context.Context, req resource.
 	// Create new NotificationTelegram
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationTelegramResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationTelegramResourceName, err)
 
 		return
 	}
@@ -251,6 +261,9 @@ func (r *NotificationTelegramResource) Create(ctx context.Context, req resource.
 }
 
 func (r *NotificationTelegramResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationTelegram
 
@@ -261,8 +274,12 @@ func (r *NotificationTelegramResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get NotificationTelegram current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationTelegramResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationTelegramResourceName, err))
 
 		return
@@ -275,6 +292,9 @@ func (r *NotificationTelegramResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *NotificationTelegramResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationTelegram
 
@@ -287,9 +307,9 @@ func (r *NotificationTelegramResource) Update(ctx context.Context, req resource.
 	// Update NotificationTelegram
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationTelegramResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationTelegramResourceName, err)
 
 		return
 	}
@@ -301,6 +321,9 @@ func (r *NotificationTelegramResource) Update(ctx context.Context, req resource.
 }
 
 func (r *NotificationTelegramResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -310,7 +333,7 @@ func (r *NotificationTelegramResource) Delete(ctx context.Context, req resource.
 	}
 
 	// Delete NotificationTelegram current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationTelegramResourceName, err))
 
@@ -322,7 +345,7 @@ func (r *NotificationTelegramResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *NotificationTelegramResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationTelegramImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationTelegramResourceName+": "+req.ID)
 }
 
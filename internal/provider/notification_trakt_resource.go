@@ -35,12 +35,13 @@ func NewNotificationTraktResource() resource.Resource {
 // NotificationTraktResource defines the notification implementation.
 type NotificationTraktResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationTrakt describes the notification data model.
 type NotificationTrakt struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	AuthUser                    types.String `tfsdk:"auth_user"`
 	AccessToken                 types.String `tfsdk:"access_token"`
 	RefreshToken                types.String `tfsdk:"refresh_token"`
@@ -102,6 +103,7 @@ func (r *NotificationTraktResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Trakt resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Trakt](https://wiki.servarr.com/radarr/supported#trakt).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_download": schema.BoolAttribute{
 				MarkdownDescription: "On download flag.",
 				Optional:            true,
@@ -185,6 +187,9 @@ func (r *NotificationTraktResource) Configure(ctx context.Context, req resource.
 }
 
 func (r *NotificationTraktResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationTrakt
 
@@ -197,9 +202,9 @@ func (r *NotificationTraktResource) Create(ctx context.Context, req resource.Cre
 	// Create new NotificationTrakt
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationTraktResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationTraktResourceName, err)
 
 		return
 	}
@@ -211,6 +216,9 @@ func (r *NotificationTraktResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *NotificationTraktResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationTrakt
 
@@ -221,8 +229,12 @@ func (r *NotificationTraktResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get NotificationTrakt current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationTraktResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationTraktResourceName, err))
 
 		return
@@ -235,6 +247,9 @@ func (r *NotificationTraktResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *NotificationTraktResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationTrakt
 
@@ -247,9 +262,9 @@ func (r *NotificationTraktResource) Update(ctx context.Context, req resource.Upd
 	// Update NotificationTrakt
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationTraktResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationTraktResourceName, err)
 
 		return
 	}
@@ -261,6 +276,9 @@ func (r *NotificationTraktResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *NotificationTraktResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -270,7 +288,7 @@ func (r *NotificationTraktResource) Delete(ctx context.Context, req resource.Del
 	}
 
 	// Delete NotificationTrakt current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationTraktResourceName, err))
 
@@ -282,7 +300,7 @@ func (r *NotificationTraktResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *NotificationTraktResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationTraktImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationTraktResourceName+": "+req.ID)
 }
 
@@ -26,6 +26,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationTwitterResource{}
 	_ resource.ResourceWithImportState = &NotificationTwitterResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationTwitterResource{}
 )
 
 func NewNotificationTwitterResource() resource.Resource {
@@ -35,12 +36,13 @@ func NewNotificationTwitterResource() resource.Resource {
 // NotificationTwitterResource defines the notification implementation.
 type NotificationTwitterResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationTwitter describes the notification data model.
 type NotificationTwitter struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	Name                        types.String `tfsdk:"name"`
 	AccessToken                 types.String `tfsdk:"access_token"`
 	AccessTokenSecret           types.String `tfsdk:"access_token_secret"`
@@ -123,6 +125,7 @@ func (r *NotificationTwitterResource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Twitter resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Twitter](https://wiki.servarr.com/radarr/supported#twitter).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -240,7 +243,14 @@ func (r *NotificationTwitterResource) Configure(ctx context.Context, req resourc
 	}
 }
 
+func (r *NotificationTwitterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationTwitterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationTwitter
 
@@ -253,9 +263,9 @@ func (r *NotificationTwitterResource) Create(ctx context.Context, req resource.C
 	// Create new NotificationTwitter
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationTwitterResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationTwitterResourceName, err)
 
 		return
 	}
@@ -267,6 +277,9 @@ func (r *NotificationTwitterResource) Create(ctx context.Context, req resource.C
 }
 
 func (r *NotificationTwitterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationTwitter
 
@@ -277,8 +290,12 @@ func (r *NotificationTwitterResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get NotificationTwitter current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationTwitterResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationTwitterResourceName, err))
 
 		return
@@ -291,6 +308,9 @@ func (r *NotificationTwitterResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *NotificationTwitterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationTwitter
 
@@ -303,9 +323,9 @@ func (r *NotificationTwitterResource) Update(ctx context.Context, req resource.U
 	// Update NotificationTwitter
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationTwitterResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationTwitterResourceName, err)
 
 		return
 	}
@@ -317,6 +337,9 @@ func (r *NotificationTwitterResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *NotificationTwitterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -326,7 +349,7 @@ func (r *NotificationTwitterResource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Delete NotificationTwitter current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationTwitterResourceName, err))
 
@@ -338,7 +361,7 @@ func (r *NotificationTwitterResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationTwitterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationTwitterImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationTwitterResourceName+": "+req.ID)
 }
 
@@ -28,6 +28,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationWebhookResource{}
 	_ resource.ResourceWithImportState = &NotificationWebhookResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationWebhookResource{}
 )
 
 func NewNotificationWebhookResource() resource.Resource {
@@ -37,12 +38,13 @@ func NewNotificationWebhookResource() resource.Resource {
 // NotificationWebhookResource defines the notification implementation.
 type NotificationWebhookResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // NotificationWebhook describes the notification data model.
 type NotificationWebhook struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	Timeouts                    types.Object `tfsdk:"timeouts"`
 	URL                         types.String `tfsdk:"url"`
 	Name                        types.String `tfsdk:"name"`
 	Username                    types.String `tfsdk:"username"`
@@ -122,6 +124,7 @@ func (r *NotificationWebhookResource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Webhook resource.\nFor more information refer to [Notification](https://wiki.servarr.com/radarr/settings#connect) and [Webhook](https://wiki.servarr.com/radarr/supported#webhook).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -237,7 +240,14 @@ func (r *NotificationWebhookResource) Configure(ctx context.Context, req resourc
 	}
 }
 
+func (r *NotificationWebhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("on_manual_interaction_required"), onManualInteractionRequiredVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
 	var notification *NotificationWebhook
 
@@ -250,9 +260,9 @@ func (r *NotificationWebhookResource) Create(ctx context.Context, req resource.C
 	// Create new NotificationWebhook
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationWebhookResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, notificationWebhookResourceName, err)
 
 		return
 	}
@@ -264,6 +274,9 @@ func (r *NotificationWebhookResource) Create(ctx context.Context, req resource.C
 }
 
 func (r *NotificationWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
 	var notification *NotificationWebhook
 
@@ -274,8 +287,12 @@ func (r *NotificationWebhookResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get NotificationWebhook current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, notificationWebhookResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationWebhookResourceName, err))
 
 		return
@@ -288,6 +305,9 @@ func (r *NotificationWebhookResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *NotificationWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
 	var notification *NotificationWebhook
 
@@ -300,9 +320,9 @@ func (r *NotificationWebhookResource) Update(ctx context.Context, req resource.U
 	// Update NotificationWebhook
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationWebhookResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, notificationWebhookResourceName, err)
 
 		return
 	}
@@ -314,6 +334,9 @@ func (r *NotificationWebhookResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *NotificationWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -323,7 +346,7 @@ func (r *NotificationWebhookResource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Delete NotificationWebhook current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationWebhookResourceName, err))
 
@@ -335,7 +358,7 @@ func (r *NotificationWebhookResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), notificationImportLookups(r.auth.apiContext(ctx), r.client, notificationWebhookImplementation), req, resp)
 	tflog.Trace(ctx, "imported "+notificationWebhookResourceName+": "+req.ID)
 }
 
@@ -24,7 +24,7 @@ func NewNotificationsDataSource() datasource.DataSource {
 // NotificationsDataSource defines the notifications implementation.
 type NotificationsDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // Notifications describes the notifications data model.
@@ -441,8 +441,10 @@ func (d *NotificationsDataSource) Configure(ctx context.Context, req datasource.
 }
 
 func (d *NotificationsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get notifications current value
-	response, _, err := d.client.NotificationAPI.ListNotification(d.auth).Execute()
+	response, _, err := d.client.NotificationAPI.ListNotification(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, notificationsDataSourceName, err))
 
@@ -2,25 +2,59 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/devopsarr/radarr-go/radarr"
 	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// define default values for provider configuration.
+const (
+	defaultMaxRetries     = 3
+	defaultRetryMinWait   = 1
+	defaultRetryMaxWait   = 30
+	defaultRequestTimeout = 120
+	defaultStartupTimeout = 0
+	startupPollInterval   = 5 * time.Second
+)
+
+// define the minimum Radarr versions of fields added in newer releases.
+const (
+	onManualInteractionRequiredVersion = "5.0.0"
+	minUpgradeFormatScoreVersion       = "5.15.0"
 )
 
 // needed for tf debug mode
 // var stderr = os.Stderr
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ provider.Provider = &RadarrProvider{}
+var (
+	_ provider.Provider              = &RadarrProvider{}
+	_ provider.ProviderWithFunctions = &RadarrProvider{}
+)
 
 // RadarrProvider defines the provider implementation.
 type RadarrProvider struct {
@@ -32,9 +66,20 @@ type RadarrProvider struct {
 
 // Radarr describes the provider data model.
 type Radarr struct {
-	ExtraHeaders types.Set    `tfsdk:"extra_headers"`
-	APIKey       types.String `tfsdk:"api_key"`
-	URL          types.String `tfsdk:"url"`
+	ExtraHeaders       types.Set    `tfsdk:"extra_headers"`
+	APIKey             types.String `tfsdk:"api_key"`
+	URL                types.String `tfsdk:"url"`
+	CACertificate      types.String `tfsdk:"ca_certificate"`
+	CACertificateFile  types.String `tfsdk:"ca_certificate_file"`
+	ClientCertificate  types.String `tfsdk:"client_certificate"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	MinimumVersion     types.String `tfsdk:"minimum_version"`
+	MaxRetries         types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait       types.Int64  `tfsdk:"retry_min_wait"`
+	RetryMaxWait       types.Int64  `tfsdk:"retry_max_wait"`
+	RequestTimeout     types.Int64  `tfsdk:"request_timeout"`
+	StartupTimeout     types.Int64  `tfsdk:"startup_timeout"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 }
 
 // ExtraHeader is part of Radarr.
@@ -45,8 +90,161 @@ type ExtraHeader struct {
 
 // RadarrData defines auth and client to be used when connecting to Radarr.
 type RadarrData struct {
-	Auth   context.Context
 	Client *radarr.APIClient
+	Auth   radarrAuth
+}
+
+// radarrAuth defines the values needed to authenticate API calls, the detected Radarr version and the read cache.
+type radarrAuth struct {
+	serverVariables map[string]string
+	version         *radarrVersion
+	// cache holds the read calls of the provider process, it is shared by all resources and data sources.
+	cache  *helpers.CacheTransport
+	apiKey string
+}
+
+// radarrVersion fetches the Radarr version once per provider run.
+type radarrVersion struct {
+	// client retries transient failures, probe sends single requests while waiting for Radarr.
+	client *radarr.APIClient
+	probe  *radarr.APIClient
+	// err is the unsupported version error, kept for the whole run.
+	err       error
+	minimum   string
+	value     string
+	mu        sync.Mutex
+	attempted bool
+}
+
+// apiContext derives the context for API calls from the operation context.
+func (a radarrAuth) apiContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(
+		ctx,
+		radarr.ContextAPIKeys,
+		map[string]radarr.APIKey{
+			"X-Api-Key": {Key: a.apiKey},
+		},
+	)
+
+	return context.WithValue(ctx, radarr.ContextServerVariables, a.serverVariables)
+}
+
+// invalidateCache drops the cached reads, needed when Radarr changes its state after the API call returned.
+func (a radarrAuth) invalidateCache() {
+	if a.cache != nil {
+		a.cache.Invalidate()
+	}
+}
+
+// operationContext derives the context for API calls from a resource operation, bound to its configured timeout.
+func (a radarrAuth) operationContext(ctx context.Context, source helpers.AttributeGetter, operation string, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	ctx, cancel := helpers.Timeout(ctx, source, operation, diags)
+
+	return a.apiContext(ctx), cancel
+}
+
+// check reads the Radarr version while waiting for Radarr, verifying that Radarr accepts the API key and satisfies the minimum version.
+func (v *radarrVersion) check(ctx context.Context) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return v.fetch(ctx, v.probe)
+}
+
+// current returns the Radarr version, fetching it if it was not read yet.
+// An empty version is returned when Radarr cannot be reached, since it might not be provisioned yet,
+// unless a minimum version is required. The version is fetched again on the next call after a transport error.
+func (v *radarrVersion) current(ctx context.Context) (string, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.value != "" || v.err != nil || v.attempted {
+		return v.value, v.err
+	}
+
+	err := v.fetch(ctx, v.client)
+	if err == nil || v.err != nil {
+		return v.value, v.err
+	}
+
+	if v.minimum != "" {
+		return "", fmt.Errorf("unable to check the minimum version %s, got error: %w", v.minimum, err)
+	}
+
+	tflog.Debug(ctx, "unable to detect Radarr version", map[string]interface{}{
+		"error": err.Error(),
+	})
+
+	// Radarr answered, asking again would give the same response.
+	var apiErr *radarr.GenericOpenAPIError
+	v.attempted = errors.As(err, &apiErr)
+
+	return "", nil
+}
+
+func (v *radarrVersion) fetch(ctx context.Context, client *radarr.APIClient) error {
+	status, httpResp, err := client.SystemAPI.GetSystemStatus(ctx).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusUnauthorized {
+			return fmt.Errorf("%w: API key was rejected", err)
+		}
+
+		return err
+	}
+
+	v.value = status.GetVersion()
+
+	if v.minimum == "" {
+		return nil
+	}
+
+	compare, err := helpers.CompareVersions(v.value, v.minimum)
+	if err != nil {
+		return err
+	}
+
+	if compare < 0 {
+		v.err = fmt.Errorf("%w: Radarr version %s is older than the minimum version %s", helpers.ErrUnsupportedVersion, v.value, v.minimum)
+
+		return v.err
+	}
+
+	return nil
+}
+
+// requireVersion adds an error if the attribute is configured but not supported by the Radarr version.
+func (a radarrAuth) requireVersion(ctx context.Context, config tfsdk.Config, attribute path.Path, minimum string, diags *diag.Diagnostics) {
+	var value attr.Value
+
+	if config.Raw.IsNull() {
+		return
+	}
+
+	diags.Append(config.GetAttribute(ctx, attribute, &value)...)
+
+	if value == nil || value.IsNull() || value.IsUnknown() || a.version == nil {
+		return
+	}
+
+	version, err := a.version.current(a.apiContext(ctx))
+	if err != nil {
+		diags.AddError("Unsupported Radarr Version", err.Error())
+
+		return
+	}
+
+	// skip the check if the version is still unknown, the API will validate the request.
+	if version == "" {
+		return
+	}
+
+	if compare, err := helpers.CompareVersions(version, minimum); err == nil && compare < 0 {
+		diags.AddAttributeError(
+			attribute,
+			"Unsupported Radarr Version",
+			fmt.Sprintf("%s requires Radarr %s or later, the server is running %s.", attribute, minimum, version),
+		)
+	}
 }
 
 func (p *RadarrProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -83,6 +281,75 @@ func (p *RadarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 					},
 				},
 			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries for transient failures (e.g. Radarr restarting, `database is locked` errors or proxy `502`/`503` responses). Non idempotent requests are only retried when the failure is clearly transient. Defaults to `3`. Can be specified via the `RADARR_MAX_RETRIES` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_min_wait": schema.Int64Attribute{
+				MarkdownDescription: "Minimum time in seconds to wait before retrying a request, doubled at each retry. Cannot be greater than `retry_max_wait`. Defaults to `1`. Can be specified via the `RADARR_RETRY_MIN_WAIT` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time in seconds to wait before retrying a request. Defaults to `30`. Can be specified via the `RADARR_RETRY_MAX_WAIT` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate used to verify the Radarr server certificate, in addition to the system ones and `ca_certificate_file`. Can be specified via the `RADARR_CA_CERTIFICATE` environment variable.",
+				Optional:            true,
+			},
+			"ca_certificate_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded CA certificate used to verify the Radarr server certificate, in addition to the system ones and `ca_certificate`. Can be specified via the `RADARR_CA_CERTIFICATE_FILE` environment variable.",
+				Optional:            true,
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS authentication. Can be specified via the `RADARR_CLIENT_CERTIFICATE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client private key for mutual TLS authentication. Can be specified via the `RADARR_CLIENT_KEY` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_certificate")),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the Radarr server certificate. This should only be used for testing. Can be specified via the `RADARR_INSECURE_SKIP_VERIFY` environment variable.",
+				Optional:            true,
+			},
+			"minimum_version": schema.StringAttribute{
+				MarkdownDescription: "Minimum Radarr version (e.g. `5.3.6`) required by the configuration. API calls fail with a clear error on older servers, or when the version cannot be detected. Can be specified via the `RADARR_MINIMUM_VERSION` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^v?[0-9]+(\.[0-9]+){0,3}$`), "must be a dotted version such as `5.3.6`"),
+				},
+			},
+			"startup_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Time in seconds to wait for Radarr to answer with a valid API key before the first API call, useful when Radarr is provisioned in the same run. With `0` Radarr is not waited for and the version is read once when the provider is configured. Defaults to `0`. Can be specified via the `RADARR_STARTUP_TIMEOUT` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds for a single API call, retries included. `0` disables the timeout. Defaults to `120`. Can be specified via the `RADARR_REQUEST_TIMEOUT` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 		},
 	}
 }
@@ -147,18 +414,109 @@ func (p *RadarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		}
 	}
 
-	// Set context for API calls
-	auth := context.WithValue(
-		context.Background(),
-		radarr.ContextAPIKeys,
-		map[string]radarr.APIKey{
-			"X-Api-Key": {Key: key},
+	// Retry transient failures
+	maxRetries := int64ValueOrEnv(data.MaxRetries, "RADARR_MAX_RETRIES", defaultMaxRetries, &resp.Diagnostics)
+	retryMinWait := int64ValueOrEnv(data.RetryMinWait, "RADARR_RETRY_MIN_WAIT", defaultRetryMinWait, &resp.Diagnostics)
+	retryMaxWait := int64ValueOrEnv(data.RetryMaxWait, "RADARR_RETRY_MAX_WAIT", defaultRetryMaxWait, &resp.Diagnostics)
+	requestTimeout := int64ValueOrEnv(data.RequestTimeout, "RADARR_REQUEST_TIMEOUT", defaultRequestTimeout, &resp.Diagnostics)
+	startupTimeout := int64ValueOrEnv(data.StartupTimeout, "RADARR_STARTUP_TIMEOUT", defaultStartupTimeout, &resp.Diagnostics)
+
+	if retryMinWait > retryMaxWait {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_min_wait"),
+			"Invalid retry wait",
+			fmt.Sprintf("retry_min_wait (%d) cannot be greater than retry_max_wait (%d)", retryMinWait, retryMaxWait),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set TLS options
+	tlsOptions := helpers.TLSOptions{
+		CACertificate:      stringValueOrEnv(data.CACertificate, "RADARR_CA_CERTIFICATE"),
+		CACertificateFile:  stringValueOrEnv(data.CACertificateFile, "RADARR_CA_CERTIFICATE_FILE"),
+		ClientCertificate:  stringValueOrEnv(data.ClientCertificate, "RADARR_CLIENT_CERTIFICATE"),
+		ClientKey:          stringValueOrEnv(data.ClientKey, "RADARR_CLIENT_KEY"),
+		InsecureSkipVerify: boolValueOrEnv(data.InsecureSkipVerify, "RADARR_INSECURE_SKIP_VERIFY", &resp.Diagnostics),
+	}
+
+	tlsConfig, err := tlsOptions.TLSConfig()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to configure TLS",
+			err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The default transport might have been replaced, e.g. by instrumentation
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = defaultTransport.Clone()
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	logged := &helpers.LoggingTransport{Transport: transport, LogBodies: helpers.HTTPTraceEnabled()}
+
+	retrying := &helpers.TimeoutTransport{
+		Transport: &helpers.RetryTransport{
+			Transport:  logged,
+			MaxRetries: int(maxRetries),
+			MinWait:    time.Duration(retryMinWait) * time.Second,
+			MaxWait:    time.Duration(retryMaxWait) * time.Second,
 		},
-	)
-	auth = context.WithValue(auth, radarr.ContextServerVariables, map[string]string{
-		"protocol": parsedAPIURL.Scheme,
-		"hostpath": parsedAPIURL.Host + parsedAPIURL.Path,
-	})
+		Timeout: time.Duration(requestTimeout) * time.Second,
+	}
+
+	// The status probe is sent without retries, since polling already retries it
+	probeConfig := *config
+	probeConfig.HTTPClient = &http.Client{
+		Transport: &helpers.TimeoutTransport{
+			Transport: logged,
+			Timeout:   time.Duration(requestTimeout) * time.Second,
+		},
+	}
+	versionConfig := *config
+	versionConfig.HTTPClient = &http.Client{Transport: retrying}
+
+	// Set auth for API calls, each call derives its context from the operation one
+	auth := radarrAuth{
+		apiKey: key,
+		serverVariables: map[string]string{
+			"protocol": parsedAPIURL.Scheme,
+			"hostpath": parsedAPIURL.Host + parsedAPIURL.Path,
+		},
+		version: &radarrVersion{
+			client:  radarr.NewAPIClient(&versionConfig),
+			probe:   radarr.NewAPIClient(&probeConfig),
+			minimum: stringValueOrEnv(data.MinimumVersion, "RADARR_MINIMUM_VERSION"),
+		},
+	}
+
+	var api http.RoundTripper = retrying
+
+	if startupTimeout > 0 {
+		// Wait for Radarr before the first API call, the version is read while waiting
+		api = &helpers.StartupTransport{
+			Transport: retrying,
+			Check:     auth.version.check,
+			Timeout:   time.Duration(startupTimeout) * time.Second,
+			Interval:  startupPollInterval,
+		}
+	} else if _, err := auth.version.current(auth.apiContext(ctx)); err != nil {
+		resp.Diagnostics.AddError("Unsupported Radarr Version", err.Error())
+
+		return
+	}
+
+	// Read calls are cached for the whole run, any write invalidates the cache
+	auth.cache = &helpers.CacheTransport{Transport: api}
+	config.HTTPClient = &http.Client{Transport: auth.cache}
 
 	radarrData := RadarrData{
 		Auth:   auth,
@@ -243,6 +601,10 @@ func (p *RadarrProvider) Resources(_ context.Context) []func() resource.Resource
 
 		// Movies
 		NewMovieResource,
+		NewMoviesResource,
+		NewCollectionResource,
+		NewManualImportResource,
+		NewMovieFileResource,
 
 		// Notifications
 		NewNotificationResource,
@@ -278,6 +640,7 @@ func (p *RadarrProvider) Resources(_ context.Context) []func() resource.Resource
 
 		// System
 		NewHostResource,
+		NewCommandResource,
 
 		// Tags
 		NewTagResource,
@@ -307,6 +670,8 @@ func (p *RadarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		// Media Management
 		NewMediaManagementDataSource,
 		NewNamingDataSource,
+		NewNamingExamplesDataSource,
+		NewRenamePreviewDataSource,
 		NewRootFolderDataSource,
 		NewRootFoldersDataSource,
 
@@ -318,6 +683,12 @@ func (p *RadarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		// Movies
 		NewMovieDataSource,
 		NewMoviesDataSource,
+		NewMovieLookupDataSource,
+		NewMovieCreditsDataSource,
+		NewManualImportCandidatesDataSource,
+		NewMovieFilesDataSource,
+		NewCollectionDataSource,
+		NewCollectionsDataSource,
 
 		// Notifications
 		NewImportListDataSource,
@@ -365,6 +736,12 @@ func (p *RadarrProvider) DataSources(_ context.Context) []func() datasource.Data
 	}
 }
 
+func (p *RadarrProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewRenderMovieNameFunction,
+	}
+}
+
 // New returns the provider with a specific version.
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -374,11 +751,66 @@ func New(version string) func() provider.Provider {
 	}
 }
 
+// int64ValueOrEnv returns the configured value, falling back to the environment variable and then to the default.
+func int64ValueOrEnv(value types.Int64, env string, fallback int64, diags *diag.Diagnostics) int64 {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueInt64()
+	}
+
+	envValue := os.Getenv(env)
+	if envValue == "" {
+		return fallback
+	}
+
+	parsed, err := strconv.ParseInt(envValue, 10, 64)
+	if err != nil || parsed < 0 {
+		diags.AddError(
+			"Unable to parse environment variable",
+			fmt.Sprintf("%s must be a non negative integer, got: %s", env, envValue),
+		)
+
+		return fallback
+	}
+
+	return parsed
+}
+
+// stringValueOrEnv returns the configured value, falling back to the environment variable.
+func stringValueOrEnv(value types.String, env string) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+
+	return os.Getenv(env)
+}
+
+// boolValueOrEnv returns the configured value, falling back to the environment variable.
+func boolValueOrEnv(value types.Bool, env string, diags *diag.Diagnostics) bool {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool()
+	}
+
+	envValue := os.Getenv(env)
+	if envValue == "" {
+		return false
+	}
+
+	parsed, err := strconv.ParseBool(envValue)
+	if err != nil {
+		diags.AddError(
+			"Unable to parse environment variable",
+			fmt.Sprintf("%s must be a boolean, got: %s", env, envValue),
+		)
+	}
+
+	return parsed
+}
+
 // ResourceConfigure is a helper function to set the client for a specific resource.
-func resourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) (context.Context, *radarr.APIClient) {
+func resourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) (radarrAuth, *radarr.APIClient) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
-		return nil, nil
+		return radarrAuth{}, nil
 	}
 
 	providerData, ok := req.ProviderData.(*RadarrData)
@@ -388,16 +820,16 @@ func resourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *r
 			fmt.Sprintf("Expected *RadarrData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
-		return nil, nil
+		return radarrAuth{}, nil
 	}
 
 	return providerData.Auth, providerData.Client
 }
 
-func dataSourceConfigure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) (context.Context, *radarr.APIClient) {
+func dataSourceConfigure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) (radarrAuth, *radarr.APIClient) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
-		return nil, nil
+		return radarrAuth{}, nil
 	}
 
 	providerData, ok := req.ProviderData.(*RadarrData)
@@ -407,7 +839,7 @@ func dataSourceConfigure(_ context.Context, req datasource.ConfigureRequest, res
 			fmt.Sprintf("Expected *RadarrData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
-		return nil, nil
+		return radarrAuth{}, nil
 	}
 
 	return providerData.Auth, providerData.Client
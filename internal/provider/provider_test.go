@@ -1,12 +1,24 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"sync/atomic"
 	"testing"
 
 	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -49,3 +61,266 @@ provider "radarr" {
 	]
   }
 `
+
+func TestRequireVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value   tftypes.Value
+		version string
+		minimum string
+		err     string
+		status  int
+		calls   int32
+	}{
+		"supported": {
+			value:   tftypes.NewValue(tftypes.Bool, true),
+			version: "5.3.6",
+			status:  http.StatusOK,
+			calls:   1,
+		},
+		"unsupported": {
+			value:   tftypes.NewValue(tftypes.Bool, true),
+			version: "4.7.5",
+			status:  http.StatusOK,
+			calls:   1,
+			err:     "flag requires Radarr 5.0.0 or later, the server is running 4.7.5.",
+		},
+		"not configured": {
+			value:   tftypes.NewValue(tftypes.Bool, nil),
+			version: "4.7.5",
+			status:  http.StatusOK,
+		},
+		"unknown": {
+			value:   tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+			version: "4.7.5",
+			status:  http.StatusOK,
+		},
+		"unreachable": {
+			value:  tftypes.NewValue(tftypes.Bool, true),
+			status: http.StatusBadRequest,
+			calls:  1,
+		},
+		"minimum version": {
+			value:   tftypes.NewValue(tftypes.Bool, true),
+			version: "5.3.6",
+			minimum: "5.4",
+			status:  http.StatusOK,
+			calls:   1,
+			err:     "Radarr version 5.3.6 is older than the minimum version 5.4",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v3/system/status", r.URL.Path)
+				atomic.AddInt32(&calls, 1)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(`{"version":"` + test.version + `"}`))
+			}))
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			auth := radarrAuth{
+				serverVariables: map[string]string{
+					"protocol": serverURL.Scheme,
+					"hostpath": serverURL.Host,
+				},
+				version: &radarrVersion{
+					client:  radarr.NewAPIClient(radarr.NewConfiguration()),
+					minimum: test.minimum,
+				},
+			}
+			config := tfsdk.Config{
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"flag": schema.BoolAttribute{Optional: true},
+					},
+				},
+				Raw: tftypes.NewValue(
+					tftypes.Object{AttributeTypes: map[string]tftypes.Type{"flag": tftypes.Bool}},
+					map[string]tftypes.Value{"flag": test.value},
+				),
+			}
+
+			// the version is fetched once for all the checks
+			for range 2 {
+				var diags diag.Diagnostics
+
+				auth.requireVersion(context.Background(), config, path.Root("flag"), "5.0.0", &diags)
+
+				if test.err == "" {
+					assert.False(t, diags.HasError())
+
+					continue
+				}
+
+				assert.True(t, diags.HasError())
+				assert.Contains(t, diags.Errors()[0].Detail(), test.err)
+			}
+
+			assert.Equal(t, test.calls, atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+// testProviderConfig builds a provider configuration with the given values, leaving the others null.
+func testProviderConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	var resp provider.SchemaResponse
+
+	New("test")().Schema(context.Background(), provider.SchemaRequest{}, &resp)
+
+	objectType, _ := resp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		}
+	}
+
+	return tfsdk.Config{Schema: resp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+}
+
+func TestProviderConfigure(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		values map[string]tftypes.Value
+		err    string
+	}{
+		"valid": {
+			values: map[string]tftypes.Value{
+				"retry_min_wait": tftypes.NewValue(tftypes.Number, 5),
+				"retry_max_wait": tftypes.NewValue(tftypes.Number, 5),
+			},
+		},
+		"retry min wait greater than max": {
+			values: map[string]tftypes.Value{
+				"retry_min_wait": tftypes.NewValue(tftypes.Number, 10),
+				"retry_max_wait": tftypes.NewValue(tftypes.Number, 5),
+			},
+			err: "retry_min_wait (10) cannot be greater than retry_max_wait (5)",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			values := map[string]tftypes.Value{
+				"url":             tftypes.NewValue(tftypes.String, "http://localhost:7878"),
+				"api_key":         tftypes.NewValue(tftypes.String, "key"),
+				"startup_timeout": tftypes.NewValue(tftypes.Number, 1),
+			}
+			for key, value := range test.values {
+				values[key] = value
+			}
+
+			var resp provider.ConfigureResponse
+
+			New("test")().Configure(context.Background(), provider.ConfigureRequest{Config: testProviderConfig(t, values)}, &resp)
+
+			if test.err == "" {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				assert.NotNil(t, resp.ResourceData)
+
+				return
+			}
+
+			assert.True(t, resp.Diagnostics.HasError())
+			assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), test.err)
+		})
+	}
+}
+
+func TestRequireVersionRetry(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		minimum string
+		errs    []string
+	}{
+		"retried": {
+			errs: []string{"", "flag requires Radarr 5.0.0 or later, the server is running 4.7.5."},
+		},
+		"minimum version": {
+			minimum: "4.0",
+			errs:    []string{"unable to check the minimum version 4.0", "flag requires Radarr 5.0.0 or later, the server is running 4.7.5."},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+
+			// the first status call fails with a transport error.
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if atomic.AddInt32(&calls, 1) == 1 {
+					conn, _, _ := w.(http.Hijacker).Hijack()
+					conn.Close()
+
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"version":"4.7.5"}`))
+			}))
+			defer server.Close()
+
+			serverURL, _ := url.Parse(server.URL)
+			auth := radarrAuth{
+				serverVariables: map[string]string{
+					"protocol": serverURL.Scheme,
+					"hostpath": serverURL.Host,
+				},
+				version: &radarrVersion{
+					client:  radarr.NewAPIClient(radarr.NewConfiguration()),
+					minimum: test.minimum,
+				},
+			}
+			config := tfsdk.Config{
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"flag": schema.BoolAttribute{Optional: true},
+					},
+				},
+				Raw: tftypes.NewValue(
+					tftypes.Object{AttributeTypes: map[string]tftypes.Type{"flag": tftypes.Bool}},
+					map[string]tftypes.Value{"flag": tftypes.NewValue(tftypes.Bool, true)},
+				),
+			}
+
+			for _, expected := range test.errs {
+				var diags diag.Diagnostics
+
+				auth.requireVersion(context.Background(), config, path.Root("flag"), "5.0.0", &diags)
+
+				if expected == "" {
+					assert.False(t, diags.HasError())
+
+					continue
+				}
+
+				assert.True(t, diags.HasError())
+				assert.Contains(t, diags.Errors()[0].Detail(), expected)
+			}
+
+			assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+		})
+	}
+}
@@ -25,7 +25,7 @@ func NewQualityDataSource() datasource.DataSource {
 // QualityDataSource defines the quality implementation.
 type QualityDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // Quality is part of QualityGroup.
@@ -83,6 +83,8 @@ func (d *QualityDataSource) Configure(ctx context.Context, req datasource.Config
 }
 
 func (d *QualityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	var data *Quality
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -91,7 +93,7 @@ func (d *QualityDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 	// Get qualitys current value
-	response, _, err := d.client.QualityDefinitionAPI.ListQualityDefinition(d.auth).Execute()
+	response, _, err := d.client.QualityDefinitionAPI.ListQualityDefinition(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDataSourceName, err))
 
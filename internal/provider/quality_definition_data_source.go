@@ -24,7 +24,7 @@ func NewQualityDefinitionDataSource() datasource.DataSource {
 // QualityDefinitionDataSource defines the quality definitions implementation.
 type QualityDefinitionDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *QualityDefinitionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -85,6 +85,8 @@ func (d *QualityDefinitionDataSource) Configure(ctx context.Context, req datasou
 }
 
 func (d *QualityDefinitionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	var data *QualityDefinition
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -93,7 +95,7 @@ func (d *QualityDefinitionDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 	// Get qualitydefinitions current value
-	response, _, err := d.client.QualityDefinitionAPI.ListQualityDefinition(d.auth).Execute()
+	response, _, err := d.client.QualityDefinitionAPI.ListQualityDefinition(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDefinitionDataSourceName, err))
 
@@ -32,7 +32,7 @@ func NewQualityDefinitionResource() resource.Resource {
 // QualityDefinitionResource defines the quality definition implementation.
 type QualityDefinitionResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // QualityDefinition describes the quality definition data model.
@@ -48,6 +48,12 @@ type QualityDefinition struct {
 	Resolution    types.Int64   `tfsdk:"resolution"`
 }
 
+// QualityDefinitionResourceModel describes the quality definition resource data model.
+type QualityDefinitionResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	QualityDefinition
+}
+
 func (p QualityDefinition) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -71,6 +77,7 @@ func (r *QualityDefinitionResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Profiles -->\nQuality Definition resource.\nFor more information refer to [Quality Definition](https://wiki.servarr.com/radarr/settings#quality-1) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Quality Definition ID.",
 				Required:            true,
@@ -128,8 +135,11 @@ func (r *QualityDefinitionResource) Configure(ctx context.Context, req resource.
 }
 
 func (r *QualityDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var definition *QualityDefinition
+	var definition *QualityDefinitionResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &definition)...)
 
@@ -141,9 +151,9 @@ func (r *QualityDefinitionResource) Create(ctx context.Context, req resource.Cre
 	request := definition.read()
 
 	// Read to get the quality ID
-	read, _, err := r.client.QualityDefinitionAPI.GetQualityDefinitionById(r.auth, request.GetId()).Execute()
+	read, _, err := r.client.QualityDefinitionAPI.GetQualityDefinitionById(ctx, request.GetId()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityDefinitionResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, qualityDefinitionResourceName, err)
 
 		return
 	}
@@ -152,9 +162,9 @@ func (r *QualityDefinitionResource) Create(ctx context.Context, req resource.Cre
 	request.Quality.SetSource(read.Quality.GetSource())
 
 	// Create new QualityDefinition
-	response, _, err := r.client.QualityDefinitionAPI.UpdateQualityDefinition(r.auth, strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
+	response, _, err := r.client.QualityDefinitionAPI.UpdateQualityDefinition(ctx, strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityDefinitionResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, qualityDefinitionResourceName, err)
 
 		return
 	}
@@ -166,8 +176,11 @@ func (r *QualityDefinitionResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *QualityDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var definition *QualityDefinition
+	var definition *QualityDefinitionResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &definition)...)
 
@@ -176,8 +189,12 @@ func (r *QualityDefinitionResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get qualitydefinition current value
-	response, _, err := r.client.QualityDefinitionAPI.GetQualityDefinitionById(r.auth, int32(definition.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.QualityDefinitionAPI.GetQualityDefinitionById(ctx, int32(definition.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, qualityDefinitionResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDefinitionResourceName, err))
 
 		return
@@ -190,8 +207,11 @@ func (r *QualityDefinitionResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *QualityDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var definition *QualityDefinition
+	var definition *QualityDefinitionResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &definition)...)
 
@@ -203,9 +223,9 @@ func (r *QualityDefinitionResource) Update(ctx context.Context, req resource.Upd
 	request := definition.read()
 
 	// Update QualityDefinition
-	response, _, err := r.client.QualityDefinitionAPI.UpdateQualityDefinition(r.auth, strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
+	response, _, err := r.client.QualityDefinitionAPI.UpdateQualityDefinition(ctx, strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, qualityDefinitionResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, qualityDefinitionResourceName, err)
 
 		return
 	}
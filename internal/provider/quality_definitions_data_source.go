@@ -24,7 +24,7 @@ func NewQualityDefinitionsDataSource() datasource.DataSource {
 // QualityDefinitionsDataSource defines the qyality definitions implementation.
 type QualityDefinitionsDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // QualityDefinitions describes the qyality definitions data model.
@@ -103,8 +103,10 @@ func (d *QualityDefinitionsDataSource) Configure(ctx context.Context, req dataso
 }
 
 func (d *QualityDefinitionsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get qualitydefinitions current value
-	response, _, err := d.client.QualityDefinitionAPI.ListQualityDefinition(d.auth).Execute()
+	response, _, err := d.client.QualityDefinitionAPI.ListQualityDefinition(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDefinitionsDataSourceName, err))
 
@@ -23,7 +23,7 @@ func NewQualityProfileDataSource() datasource.DataSource {
 // QualityProfileDataSource defines the quality profiles implementation.
 type QualityProfileDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *QualityProfileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -48,7 +48,11 @@ func (d *QualityProfileDataSource) Schema(_ context.Context, _ datasource.Schema
 				Computed:            true,
 			},
 			"cutoff": schema.Int64Attribute{
-				MarkdownDescription: "Quality ID to which cutoff.",
+				MarkdownDescription: "Quality or group ID to which cutoff.",
+				Computed:            true,
+			},
+			"cutoff_name": schema.StringAttribute{
+				MarkdownDescription: "Quality or group name to which cutoff.",
 				Computed:            true,
 			},
 			"cutoff_format_score": schema.Int64Attribute{
@@ -149,6 +153,8 @@ func (d *QualityProfileDataSource) Configure(ctx context.Context, req datasource
 }
 
 func (d *QualityProfileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	var data *QualityProfile
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -157,7 +163,7 @@ func (d *QualityProfileDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 	// Get qualityprofiles current value
-	response, _, err := d.client.QualityProfileAPI.ListQualityProfile(d.auth).Execute()
+	response, _, err := d.client.QualityProfileAPI.ListQualityProfile(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityProfileDataSourceName, err))
 
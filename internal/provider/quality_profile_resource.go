@@ -2,11 +2,14 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"slices"
 	"strconv"
 
 	"github.com/devopsarr/radarr-go/radarr"
 	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,17 +19,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-const qualityProfileResourceName = "quality_profile"
+const (
+	qualityProfileResourceName = "quality_profile"
+	// qualityProfileFirstGroupID is the first ID Radarr gives to quality groups.
+	qualityProfileFirstGroupID = 1000
+)
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &QualityProfileResource{}
 	_ resource.ResourceWithImportState = &QualityProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &QualityProfileResource{}
 )
 
 func NewQualityProfileResource() resource.Resource {
@@ -36,7 +45,7 @@ func NewQualityProfileResource() resource.Resource {
 // QualityProfileResource defines the quality profile implementation.
 type QualityProfileResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // QualityProfile describes the quality profile data model.
@@ -44,6 +53,7 @@ type QualityProfile struct {
 	FormatItems           types.Set    `tfsdk:"format_items"`
 	QualityGroups         types.List   `tfsdk:"quality_groups"`
 	Name                  types.String `tfsdk:"name"`
+	CutoffName            types.String `tfsdk:"cutoff_name"`
 	Language              types.Object `tfsdk:"language"`
 	ID                    types.Int64  `tfsdk:"id"`
 	Cutoff                types.Int64  `tfsdk:"cutoff"`
@@ -53,6 +63,12 @@ type QualityProfile struct {
 	UpgradeAllowed        types.Bool   `tfsdk:"upgrade_allowed"`
 }
 
+// QualityProfileResourceModel describes the quality profile resource data model.
+type QualityProfileResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	QualityProfile
+}
+
 func (p QualityProfile) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -60,6 +76,7 @@ func (p QualityProfile) getType() attr.Type {
 			"format_items":             types.SetType{}.WithElementType(FormatItem{}.getType()),
 			"language":                 QualityLanguage{}.getType(),
 			"name":                     types.StringType,
+			"cutoff_name":              types.StringType,
 			"id":                       types.Int64Type,
 			"cutoff":                   types.Int64Type,
 			"min_format_score":         types.Int64Type,
@@ -123,6 +140,7 @@ func (r *QualityProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Profiles -->\nQuality Profile resource.\nFor more information refer to [Quality Profile](https://wiki.servarr.com/radarr/settings#quality-profiles) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Quality Profile ID.",
 				Computed:            true,
@@ -140,9 +158,17 @@ func (r *QualityProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 			},
 			"cutoff": schema.Int64Attribute{
-				MarkdownDescription: "Quality ID to which cutoff.",
+				MarkdownDescription: "Quality or group ID to which cutoff.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"cutoff_name": schema.StringAttribute{
+				MarkdownDescription: "Quality or group name to which cutoff, alternative to `cutoff`.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("cutoff")),
+				},
 			},
 			"cutoff_format_score": schema.Int64Attribute{
 				MarkdownDescription: "Cutoff format score.",
@@ -188,7 +214,7 @@ func (r QualityProfileResource) getQualityGroupSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				MarkdownDescription: "Quality group ID.",
+				MarkdownDescription: "Quality group ID. Defaults to the ID of the current group with the same name, or to a new one.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -212,9 +238,12 @@ func (r QualityProfileResource) getQualitySchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				MarkdownDescription: "Quality ID.",
+				MarkdownDescription: "Quality ID. Either `id` or `name` must be set.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("name")),
+				},
 				// plan on uptate is unknown for 1 item array
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
@@ -229,7 +258,7 @@ func (r QualityProfileResource) getQualitySchema() schema.Schema {
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Quality name.",
+				MarkdownDescription: "Quality name, such as `Bluray-1080p`.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -293,9 +322,63 @@ func (r *QualityProfileResource) Configure(ctx context.Context, req resource.Con
 	}
 }
 
+func (r *QualityProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.auth.requireVersion(ctx, req.Config, path.Root("min_upgrade_format_score"), minUpgradeFormatScoreVersion, &resp.Diagnostics)
+
+	// Nothing to resolve on destroy or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config, plan, state *QualityProfileResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() || config.QualityGroups.IsUnknown() {
+		return
+	}
+
+	// Resolve qualities and cutoff given by name within the timeout of the planned operation, so that the plan shows the values Radarr returns
+	operation := helpers.Update
+	if state == nil {
+		operation = helpers.Create
+	}
+
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, operation, &resp.Diagnostics)
+	defer cancel()
+
+	definitions, _, err := r.client.QualityDefinitionAPI.ListQualityDefinition(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDefinitionsDataSourceName, err))
+
+		return
+	}
+
+	var current *QualityProfile
+	if state != nil {
+		current = &state.QualityProfile
+	}
+
+	plan.resolve(ctx, &config.QualityProfile, current, definitions, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
 func (r *QualityProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var profile *QualityProfile
+	var profile *QualityProfileResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -304,12 +387,12 @@ func (r *QualityProfileResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Build Create resource
-	request := profile.read(ctx, r.getQualityIDs(&resp.Diagnostics), r.getFormatsIDs(&resp.Diagnostics), &resp.Diagnostics)
+	request := profile.read(ctx, r.getQualityIDs(ctx, &resp.Diagnostics), r.getFormatsIDs(ctx, &resp.Diagnostics), &resp.Diagnostics)
 
 	// Create new QualityProfile
-	response, _, err := r.client.QualityProfileAPI.CreateQualityProfile(r.auth).QualityProfileResource(*request).Execute()
+	response, _, err := r.client.QualityProfileAPI.CreateQualityProfile(ctx).QualityProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityProfileResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, qualityProfileResourceName, err)
 
 		return
 	}
@@ -321,8 +404,11 @@ func (r *QualityProfileResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *QualityProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var profile *QualityProfile
+	var profile *QualityProfileResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &profile)...)
 
@@ -331,8 +417,12 @@ func (r *QualityProfileResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get qualityprofile current value
-	response, _, err := r.client.QualityProfileAPI.GetQualityProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.QualityProfileAPI.GetQualityProfileById(ctx, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, qualityProfileResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityProfileResourceName, err))
 
 		return
@@ -345,8 +435,11 @@ func (r *QualityProfileResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *QualityProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var profile *QualityProfile
+	var profile *QualityProfileResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -355,12 +448,12 @@ func (r *QualityProfileResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Build Update resource
-	request := profile.read(ctx, r.getQualityIDs(&resp.Diagnostics), r.getFormatsIDs(&resp.Diagnostics), &resp.Diagnostics)
+	request := profile.read(ctx, r.getQualityIDs(ctx, &resp.Diagnostics), r.getFormatsIDs(ctx, &resp.Diagnostics), &resp.Diagnostics)
 
 	// Update QualityProfile
-	response, _, err := r.client.QualityProfileAPI.UpdateQualityProfile(r.auth, strconv.Itoa(int(request.GetId()))).QualityProfileResource(*request).Execute()
+	response, _, err := r.client.QualityProfileAPI.UpdateQualityProfile(ctx, strconv.Itoa(int(request.GetId()))).QualityProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, qualityProfileResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, qualityProfileResourceName, err)
 
 		return
 	}
@@ -372,6 +465,9 @@ func (r *QualityProfileResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *QualityProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -381,7 +477,7 @@ func (r *QualityProfileResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	// Delete qualityprofile current value
-	_, err := r.client.QualityProfileAPI.DeleteQualityProfile(r.auth, int32(ID)).Execute()
+	_, err := r.client.QualityProfileAPI.DeleteQualityProfile(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, qualityProfileResourceName, err))
 
@@ -393,10 +489,24 @@ func (r *QualityProfileResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *QualityProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), qualityProfileImportLookups(r.auth.apiContext(ctx), r.client), req, resp)
 	tflog.Trace(ctx, "imported "+qualityProfileResourceName+": "+req.ID)
 }
 
+// qualityProfileImportLookups resolves the quality profile import identifiers.
+func qualityProfileImportLookups(ctx context.Context, client *radarr.APIClient) map[string]helpers.ImportLookup {
+	return map[string]helpers.ImportLookup{
+		"name=": func(name string) (int, error) {
+			profiles, _, err := client.QualityProfileAPI.ListQualityProfile(ctx).Execute()
+			if err != nil {
+				return 0, err
+			}
+
+			return helpers.FindImportID(profiles, name, (*radarr.QualityProfileResource).GetName)
+		},
+	}
+}
+
 func (p *QualityProfile) write(ctx context.Context, profile *radarr.QualityProfileResource, diags *diag.Diagnostics) {
 	var tempDiag diag.Diagnostics
 
@@ -404,6 +514,7 @@ func (p *QualityProfile) write(ctx context.Context, profile *radarr.QualityProfi
 	p.ID = types.Int64Value(int64(profile.GetId()))
 	p.Name = types.StringValue(profile.GetName())
 	p.Cutoff = types.Int64Value(int64(profile.GetCutoff()))
+	p.CutoffName = types.StringValue(qualityProfileItemName(profile.GetItems(), profile.GetCutoff()))
 	p.CutoffFormatScore = types.Int64Value(int64(profile.GetCutoffFormatScore()))
 	p.MinFormatScore = types.Int64Value(int64(profile.GetMinFormatScore()))
 	p.MinUpgradeFormatScore = types.Int64Value(int64(profile.GetMinUpgradeFormatScore()))
@@ -441,6 +552,21 @@ func (p *QualityProfile) write(ctx context.Context, profile *radarr.QualityProfi
 	diags.Append(tempDiag...)
 }
 
+// qualityProfileItemName returns the name of the group or single quality with the given ID.
+func qualityProfileItemName(items []radarr.QualityProfileQualityItemResource, id int32) string {
+	for _, i := range items {
+		if len(i.GetItems()) > 0 && i.GetId() == id {
+			return i.GetName()
+		}
+
+		if len(i.GetItems()) == 0 && i.Quality.GetId() == id {
+			return i.Quality.GetName()
+		}
+	}
+
+	return ""
+}
+
 func (g *QualityGroup) write(ctx context.Context, group *radarr.QualityProfileQualityItemResource, diags *diag.Diagnostics) {
 	var tempDiag diag.Diagnostics
 
@@ -621,9 +747,210 @@ func (l *QualityLanguage) read() *radarr.Language {
 	return language
 }
 
-func (r QualityProfileResource) getQualityIDs(diags *diag.Diagnostics) []int32 {
+// resolve fills the qualities given by name or ID, the missing group IDs and the cutoff from the configuration.
+func (p *QualityProfile) resolve(ctx context.Context, config, state *QualityProfile, definitions []radarr.QualityDefinitionResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	configGroups := make([]QualityGroup, len(config.QualityGroups.Elements()))
+	diags.Append(config.QualityGroups.ElementsAs(ctx, &configGroups, false)...)
+
+	groups := make([]QualityGroup, len(p.QualityGroups.Elements()))
+	diags.Append(p.QualityGroups.ElementsAs(ctx, &groups, false)...)
+
+	if diags.HasError() || len(groups) != len(configGroups) {
+		return
+	}
+
+	usedIDs := make([]int64, 0, len(groups))
+
+	for _, g := range configGroups {
+		if !g.ID.IsNull() && !g.ID.IsUnknown() {
+			usedIDs = append(usedIDs, g.ID.ValueInt64())
+		}
+	}
+
+	newGroups := make([]int, 0, len(groups))
+
+	for i := range groups {
+		groups[i].resolveQualities(ctx, &configGroups[i], definitions, path.Root("quality_groups").AtListIndex(i), diags)
+
+		if len(groups[i].Qualities.Elements()) < 2 || !configGroups[i].ID.IsNull() {
+			continue
+		}
+
+		// Keep the ID of the current group with the same name
+		if id, ok := state.groupID(ctx, configGroups[i].Name, diags); ok && !slices.Contains(usedIDs, id) {
+			groups[i].ID = types.Int64Value(id)
+			usedIDs = append(usedIDs, id)
+
+			continue
+		}
+
+		newGroups = append(newGroups, i)
+	}
+
+	nextID := int64(qualityProfileFirstGroupID)
+
+	for _, i := range newGroups {
+		for slices.Contains(usedIDs, nextID) {
+			nextID++
+		}
+
+		groups[i].ID = types.Int64Value(nextID)
+		nextID++
+	}
+
+	if diags.HasError() {
+		return
+	}
+
+	p.QualityGroups, tempDiag = types.ListValueFrom(ctx, QualityGroup{}.getType(), groups)
+	diags.Append(tempDiag...)
+	p.resolveCutoff(ctx, config, groups, diags)
+}
+
+// resolveCutoff matches the cutoff name or ID with the allowed groups and qualities.
+func (p *QualityProfile) resolveCutoff(ctx context.Context, config *QualityProfile, groups []QualityGroup, diags *diag.Diagnostics) {
+	allowed := make(map[string]int64, len(groups))
+
+	for _, g := range groups {
+		qualities := make([]Quality, len(g.Qualities.Elements()))
+		diags.Append(g.Qualities.ElementsAs(ctx, &qualities, false)...)
+
+		name, id := g.Name, g.ID
+		if len(qualities) == 1 {
+			name, id = qualities[0].Name, qualities[0].ID
+		}
+
+		// The cutoff cannot be checked until all the allowed items are known
+		if g.Qualities.IsUnknown() || name.IsNull() || name.IsUnknown() || id.IsNull() || id.IsUnknown() {
+			return
+		}
+
+		allowed[name.ValueString()] = id.ValueInt64()
+	}
+
+	switch {
+	case !config.CutoffName.IsNull() && !config.CutoffName.IsUnknown():
+		id, ok := allowed[config.CutoffName.ValueString()]
+		if !ok {
+			diags.AddAttributeError(path.Root("cutoff_name"), helpers.ValidationError, "cutoff must be one of the allowed qualities or groups, got "+config.CutoffName.ValueString())
+
+			return
+		}
+
+		p.Cutoff = types.Int64Value(id)
+	case !config.Cutoff.IsNull() && !config.Cutoff.IsUnknown():
+		for name, id := range allowed {
+			if id == config.Cutoff.ValueInt64() {
+				p.CutoffName = types.StringValue(name)
+
+				return
+			}
+		}
+
+		diags.AddAttributeError(path.Root("cutoff"), helpers.ValidationError, fmt.Sprintf("cutoff must be one of the allowed qualities or groups, got %d", config.Cutoff.ValueInt64()))
+	}
+}
+
+// groupID returns the ID of the quality group with the given name.
+func (p *QualityProfile) groupID(ctx context.Context, name types.String, diags *diag.Diagnostics) (int64, bool) {
+	if p == nil || name.IsNull() || name.IsUnknown() {
+		return 0, false
+	}
+
+	groups := make([]QualityGroup, len(p.QualityGroups.Elements()))
+	diags.Append(p.QualityGroups.ElementsAs(ctx, &groups, false)...)
+
+	for _, g := range groups {
+		if g.Name.Equal(name) && !g.ID.IsNull() && !g.ID.IsUnknown() {
+			return g.ID.ValueInt64(), true
+		}
+	}
+
+	return 0, false
+}
+
+// resolveQualities fills the qualities given by name or ID from the quality definitions.
+func (g *QualityGroup) resolveQualities(ctx context.Context, config *QualityGroup, definitions []radarr.QualityDefinitionResource, groupPath path.Path, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	if config.Qualities.IsUnknown() {
+		return
+	}
+
+	configQualities := make([]Quality, len(config.Qualities.Elements()))
+	diags.Append(config.Qualities.ElementsAs(ctx, &configQualities, false)...)
+
+	qualities := make([]Quality, len(g.Qualities.Elements()))
+	diags.Append(g.Qualities.ElementsAs(ctx, &qualities, false)...)
+
+	if diags.HasError() || len(qualities) != len(configQualities) {
+		return
+	}
+
+	for i, q := range configQualities {
+		if quality, ok := q.resolve(definitions, groupPath.AtName("qualities").AtListIndex(i), diags); ok {
+			qualities[i] = quality
+		}
+	}
+
+	g.Qualities, tempDiag = types.ListValueFrom(ctx, Quality{}.getType(), qualities)
+	diags.Append(tempDiag...)
+}
+
+// resolve looks up the quality definition by name, or by ID when the name is not set, and fills the values not configured.
+func (q Quality) resolve(definitions []radarr.QualityDefinitionResource, qualityPath path.Path, diags *diag.Diagnostics) (Quality, bool) {
+	byName := !q.Name.IsNull() && !q.Name.IsUnknown()
+	if !byName && (q.ID.IsNull() || q.ID.IsUnknown()) {
+		return q, false
+	}
+
+	for _, d := range definitions {
+		quality := d.GetQuality()
+
+		if (byName && quality.GetName() != q.Name.ValueString()) || (!byName && int64(quality.GetId()) != q.ID.ValueInt64()) {
+			continue
+		}
+
+		if byName && !q.ID.IsNull() && !q.ID.IsUnknown() && q.ID.ValueInt64() != int64(quality.GetId()) {
+			diags.AddAttributeError(qualityPath.AtName("id"), helpers.ValidationError, fmt.Sprintf("quality %s has ID %d", quality.GetName(), quality.GetId()))
+
+			return q, false
+		}
+
+		resolved := q
+		if resolved.ID.IsNull() {
+			resolved.ID = types.Int64Value(int64(quality.GetId()))
+		}
+
+		if resolved.Name.IsNull() {
+			resolved.Name = types.StringValue(quality.GetName())
+		}
+
+		if resolved.Source.IsNull() {
+			resolved.Source = types.StringValue(string(quality.GetSource()))
+		}
+
+		if resolved.Resolution.IsNull() {
+			resolved.Resolution = types.Int64Value(int64(quality.GetResolution()))
+		}
+
+		return resolved, true
+	}
+
+	if byName {
+		diags.AddAttributeError(qualityPath.AtName("name"), helpers.ValidationError, "no quality named "+q.Name.ValueString())
+	} else {
+		diags.AddAttributeError(qualityPath.AtName("id"), helpers.ValidationError, fmt.Sprintf("no quality with ID %d", q.ID.ValueInt64()))
+	}
+
+	return q, false
+}
+
+func (r QualityProfileResource) getQualityIDs(ctx context.Context, diags *diag.Diagnostics) []int32 {
 	// Get qualitydefinitions current value
-	qualities, _, err := r.client.QualityDefinitionAPI.ListQualityDefinition(r.auth).Execute()
+	qualities, _, err := r.client.QualityDefinitionAPI.ListQualityDefinition(ctx).Execute()
 	if err != nil {
 		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDefinitionsDataSourceName, err))
 
@@ -642,9 +969,9 @@ func (r QualityProfileResource) getQualityIDs(diags *diag.Diagnostics) []int32 {
 	return qualityIDs
 }
 
-func (r QualityProfileResource) getFormatsIDs(diags *diag.Diagnostics) []int32 {
+func (r QualityProfileResource) getFormatsIDs(ctx context.Context, diags *diag.Diagnostics) []int32 {
 	// Get qualitydefinitions current value
-	formats, _, err := r.client.CustomFormatAPI.ListCustomFormat(r.auth).Execute()
+	formats, _, err := r.client.CustomFormatAPI.ListCustomFormat(ctx).Execute()
 	if err != nil {
 		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, customFormatsDataSourceName, err))
 
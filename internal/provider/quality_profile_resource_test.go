@@ -37,6 +37,27 @@ func TestAccQualityProfileResource(t *testing.T) {
 				Config: testAccQualityProfileResourceConfig("example-HD"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("radarr_quality_profile.test", "name", "example-HD"),
+					resource.TestCheckResourceAttr("radarr_quality_profile.test", "cutoff_name", "WEB 2160p"),
+				),
+			},
+			// Invalid cutoff
+			{
+				Config:      testAccQualityProfileResourceNameConfig("example-HD", "Remux-2160p"),
+				ExpectError: regexp.MustCompile("cutoff must be one of the allowed qualities or groups"),
+			},
+			// Missing quality name and ID
+			{
+				Config:      testAccQualityProfileResourceMissingQualityConfig(),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Update by name and Read testing
+			{
+				Config: testAccQualityProfileResourceNameConfig("example-HD", "Bluray-2160p"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_quality_profile.test", "cutoff", "19"),
+					resource.TestCheckResourceAttr("radarr_quality_profile.test", "quality_groups.0.id", "2000"),
+					resource.TestCheckResourceAttr("radarr_quality_profile.test", "quality_groups.0.qualities.0.id", "18"),
+					resource.TestCheckResourceAttr("radarr_quality_profile.test", "quality_groups.1.qualities.0.resolution", "2160"),
 				),
 			},
 			// ImportState testing
@@ -45,6 +66,13 @@ func TestAccQualityProfileResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "radarr_quality_profile.test",
+				ImportStateId:     "name=example-HD",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -140,3 +168,50 @@ func testAccQualityProfileResourceConfig(name string) string {
 		]
 	}`, name)
 }
+
+func testAccQualityProfileResourceNameConfig(name, cutoff string) string {
+	return fmt.Sprintf(`
+	data "radarr_language" "test" {
+		name = "English"
+	}
+
+	resource "radarr_quality_profile" "test" {
+		name            = "%s"
+		upgrade_allowed = true
+		cutoff_name     = "%s"
+
+		language = data.radarr_language.test
+
+		quality_groups = [
+			{
+				name = "WEB 2160p"
+				qualities = [
+					{ name = "WEBDL-2160p" },
+					{ name = "WEBRip-2160p" },
+				]
+			},
+			{
+				qualities = [{ name = "Bluray-2160p" }]
+			}
+		]
+	}`, name, cutoff)
+}
+
+func testAccQualityProfileResourceMissingQualityConfig() string {
+	return `
+	resource "radarr_quality_profile" "test" {
+		name            = "example-HD"
+		upgrade_allowed = true
+		cutoff_name     = "Bluray-2160p"
+
+		language = {
+			id = 1
+		}
+
+		quality_groups = [
+			{
+				qualities = [{ source = "bluray" }]
+			}
+		]
+	}`
+}
@@ -24,7 +24,7 @@ func NewQualityProfilesDataSource() datasource.DataSource {
 // QualityProfilesDataSource defines the qyality profiles implementation.
 type QualityProfilesDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // QualityProfiles describes the qyality profiles data model.
@@ -64,7 +64,11 @@ func (d *QualityProfilesDataSource) Schema(_ context.Context, _ datasource.Schem
 							Computed:            true,
 						},
 						"cutoff": schema.Int64Attribute{
-							MarkdownDescription: "Quality ID to which cutoff.",
+							MarkdownDescription: "Quality or group ID to which cutoff.",
+							Computed:            true,
+						},
+						"cutoff_name": schema.StringAttribute{
+							MarkdownDescription: "Quality or group name to which cutoff.",
 							Computed:            true,
 						},
 						"cutoff_format_score": schema.Int64Attribute{
@@ -168,8 +172,10 @@ func (d *QualityProfilesDataSource) Configure(ctx context.Context, req datasourc
 }
 
 func (d *QualityProfilesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get qualityprofiles current value
-	response, _, err := d.client.QualityProfileAPI.ListQualityProfile(d.auth).Execute()
+	response, _, err := d.client.QualityProfileAPI.ListQualityProfile(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, qualityProfilesDataSourceName, err))
 
@@ -24,7 +24,7 @@ func NewRemotePathMappingDataSource() datasource.DataSource {
 // RemotePathMappingDataSource defines the remote path mapping implementation.
 type RemotePathMappingDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *RemotePathMappingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -64,6 +64,8 @@ func (d *RemotePathMappingDataSource) Configure(ctx context.Context, req datasou
 }
 
 func (d *RemotePathMappingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	var data *RemotePathMapping
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -72,7 +74,7 @@ func (d *RemotePathMappingDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 	// Get remote path mapping current value
-	response, _, err := d.client.RemotePathMappingAPI.ListRemotePathMapping(d.auth).Execute()
+	response, _, err := d.client.RemotePathMappingAPI.ListRemotePathMapping(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, remotePathMappingDataSourceName, err))
 
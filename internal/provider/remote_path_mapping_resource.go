@@ -31,7 +31,7 @@ func NewRemotePathMappingResource() resource.Resource {
 // RemotePathMappingResource defines the remote path mapping implementation.
 type RemotePathMappingResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // RemotePathMapping describes the remote path mapping data model.
@@ -42,6 +42,12 @@ type RemotePathMapping struct {
 	ID         types.Int64  `tfsdk:"id"`
 }
 
+// RemotePathMappingResourceModel describes the remote path mapping resource data model.
+type RemotePathMappingResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	RemotePathMapping
+}
+
 func (r RemotePathMapping) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -56,6 +62,7 @@ func (r *RemotePathMappingResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nRemote Path Mapping resource.\nFor more information refer to [Remote Path Mapping](https://wiki.servarr.com/radarr/settings#remote-path-mappings) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Remote Path Mapping ID.",
 				Computed:            true,
@@ -91,8 +98,11 @@ func (r *RemotePathMappingResource) Configure(ctx context.Context, req resource.
 }
 
 func (r *RemotePathMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var mapping *RemotePathMapping
+	var mapping *RemotePathMappingResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &mapping)...)
 
@@ -103,9 +113,9 @@ func (r *RemotePathMappingResource) Create(ctx context.Context, req resource.Cre
 	// Create new RemotePathMapping
 	request := mapping.read()
 
-	response, _, err := r.client.RemotePathMappingAPI.CreateRemotePathMapping(r.auth).RemotePathMappingResource(*request).Execute()
+	response, _, err := r.client.RemotePathMappingAPI.CreateRemotePathMapping(ctx).RemotePathMappingResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, remotePathMappingResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, remotePathMappingResourceName, err)
 
 		return
 	}
@@ -117,8 +127,11 @@ func (r *RemotePathMappingResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *RemotePathMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var mapping *RemotePathMapping
+	var mapping *RemotePathMappingResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &mapping)...)
 
@@ -127,8 +140,12 @@ func (r *RemotePathMappingResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get remotePathMapping current value
-	response, _, err := r.client.RemotePathMappingAPI.GetRemotePathMappingById(r.auth, int32(mapping.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.RemotePathMappingAPI.GetRemotePathMappingById(ctx, int32(mapping.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, remotePathMappingResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, remotePathMappingResourceName, err))
 
 		return
@@ -141,8 +158,11 @@ func (r *RemotePathMappingResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *RemotePathMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var mapping *RemotePathMapping
+	var mapping *RemotePathMappingResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &mapping)...)
 
@@ -153,9 +173,9 @@ func (r *RemotePathMappingResource) Update(ctx context.Context, req resource.Upd
 	// Update RemotePathMapping
 	request := mapping.read()
 
-	response, _, err := r.client.RemotePathMappingAPI.UpdateRemotePathMapping(r.auth, strconv.Itoa(int(request.GetId()))).RemotePathMappingResource(*request).Execute()
+	response, _, err := r.client.RemotePathMappingAPI.UpdateRemotePathMapping(ctx, strconv.Itoa(int(request.GetId()))).RemotePathMappingResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, remotePathMappingResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, remotePathMappingResourceName, err)
 
 		return
 	}
@@ -167,6 +187,9 @@ func (r *RemotePathMappingResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *RemotePathMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -176,7 +199,7 @@ func (r *RemotePathMappingResource) Delete(ctx context.Context, req resource.Del
 	}
 
 	// Delete remotePathMapping current value
-	_, err := r.client.RemotePathMappingAPI.DeleteRemotePathMapping(r.auth, int32(ID)).Execute()
+	_, err := r.client.RemotePathMappingAPI.DeleteRemotePathMapping(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, remotePathMappingResourceName, err))
 
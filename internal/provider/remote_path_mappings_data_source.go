@@ -24,7 +24,7 @@ func NewRemotePathMappingsDataSource() datasource.DataSource {
 // RemotePathMappingsDataSource defines the remote path mappings implementation.
 type RemotePathMappingsDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // RemotePathMappings describes the remote path mappings data model.
@@ -82,8 +82,10 @@ func (d *RemotePathMappingsDataSource) Configure(ctx context.Context, req dataso
 }
 
 func (d *RemotePathMappingsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get remotePathMappings current value
-	response, _, err := d.client.RemotePathMappingAPI.ListRemotePathMapping(d.auth).Execute()
+	response, _, err := d.client.RemotePathMappingAPI.ListRemotePathMapping(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, remotePathMappingsDataSourceName, err))
 
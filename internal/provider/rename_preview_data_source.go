@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	renamePreviewDataSourceName = "rename_preview"
+	// renamePreviewBatchSize bounds the movie IDs sent in a single query string.
+	renamePreviewBatchSize = 200
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RenamePreviewDataSource{}
+
+func NewRenamePreviewDataSource() datasource.DataSource {
+	return &RenamePreviewDataSource{}
+}
+
+// RenamePreviewDataSource defines the rename preview implementation.
+type RenamePreviewDataSource struct {
+	client *radarr.APIClient
+	auth   radarrAuth
+}
+
+// RenamePreview describes the rename preview data model.
+type RenamePreview struct {
+	Renames types.List   `tfsdk:"renames"`
+	ID      types.String `tfsdk:"id"`
+	MovieID types.Int64  `tfsdk:"movie_id"`
+}
+
+// MovieRename is part of RenamePreview.
+type MovieRename struct {
+	ExistingPath types.String `tfsdk:"existing_path"`
+	NewPath      types.String `tfsdk:"new_path"`
+	MovieID      types.Int64  `tfsdk:"movie_id"`
+	MovieFileID  types.Int64  `tfsdk:"movie_file_id"`
+}
+
+func (r MovieRename) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"existing_path": types.StringType,
+			"new_path":      types.StringType,
+			"movie_id":      types.Int64Type,
+			"movie_file_id": types.Int64Type,
+		})
+}
+
+func (d *RenamePreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + renamePreviewDataSourceName
+}
+
+func (d *RenamePreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Media Management -->\nMovie files that a rename would change, according to the current [Naming](../resources/naming) settings.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"movie_id": schema.Int64Attribute{
+				MarkdownDescription: "Movie ID. All movies are previewed if not set.",
+				Optional:            true,
+			},
+			"renames": schema.ListNestedAttribute{
+				MarkdownDescription: "Rename list, sorted by movie and file.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"movie_id": schema.Int64Attribute{
+							MarkdownDescription: "Movie ID.",
+							Computed:            true,
+						},
+						"movie_file_id": schema.Int64Attribute{
+							MarkdownDescription: "Movie file ID.",
+							Computed:            true,
+						},
+						"existing_path": schema.StringAttribute{
+							MarkdownDescription: "Current path relative to the movie folder.",
+							Computed:            true,
+						},
+						"new_path": schema.StringAttribute{
+							MarkdownDescription: "Path after the rename, relative to the movie folder.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RenamePreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *RenamePreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
+	var data *RenamePreview
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Radarr previews only the requested movies, so list them all when none is given
+	movieIDs := []int32{int32(data.MovieID.ValueInt64())}
+
+	if data.MovieID.IsNull() {
+		movies, _, err := d.client.MovieAPI.ListMovie(ctx).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, movieResourceName, err))
+
+			return
+		}
+
+		movieIDs = make([]int32, len(movies))
+		for i, m := range movies {
+			movieIDs[i] = m.GetId()
+		}
+	}
+
+	renames, err := d.listRenames(ctx, movieIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, renamePreviewDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+renamePreviewDataSourceName)
+	// Map response body to resource schema attribute
+	data.write(ctx, renames, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listRenames previews the movies in batches, keeping each query string short.
+func (d *RenamePreviewDataSource) listRenames(ctx context.Context, movieIDs []int32) ([]radarr.RenameMovieResource, error) {
+	renames := make([]radarr.RenameMovieResource, 0)
+
+	for batch := range slices.Chunk(movieIDs, renamePreviewBatchSize) {
+		response, _, err := d.client.RenameMovieAPI.ListRename(ctx).MovieId(batch).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		renames = append(renames, response...)
+	}
+
+	return renames, nil
+}
+
+func (p *RenamePreview) write(ctx context.Context, renames []radarr.RenameMovieResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	slices.SortStableFunc(renames, func(a, b radarr.RenameMovieResource) int {
+		return cmp.Or(cmp.Compare(a.GetMovieId(), b.GetMovieId()), cmp.Compare(a.GetMovieFileId(), b.GetMovieFileId()))
+	})
+
+	list := make([]MovieRename, len(renames))
+	for i, r := range renames {
+		list[i] = MovieRename{
+			ExistingPath: types.StringValue(r.GetExistingPath()),
+			NewPath:      types.StringValue(r.GetNewPath()),
+			MovieID:      types.Int64Value(int64(r.GetMovieId())),
+			MovieFileID:  types.Int64Value(int64(r.GetMovieFileId())),
+		}
+	}
+
+	// the ID identifies the previewed movies, not the result.
+	p.ID = types.StringValue("all")
+
+	if !p.MovieID.IsNull() {
+		p.ID = types.StringValue(strconv.Itoa(int(p.MovieID.ValueInt64())))
+	}
+
+	p.Renames, tempDiag = types.ListValueFrom(ctx, MovieRename{}.getType(), list)
+	diags.Append(tempDiag...)
+}
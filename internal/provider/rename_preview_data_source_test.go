@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccRenamePreviewDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccRenamePreviewDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccMovieResourceConfig("The Silence of the Lambs", "The_Silence_of_the_Lambs_1991", 274) + testAccRenamePreviewDataSourceConfig + `
+				data "radarr_rename_preview" "movie" {
+					movie_id = radarr_movie.test.id
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.radarr_rename_preview.test", "id"),
+					resource.TestCheckResourceAttr("data.radarr_rename_preview.movie", "renames.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRenamePreviewDataSourceConfig = `
+data "radarr_rename_preview" "test" {
+}
+`
+
+func TestRenamePreviewWrite(t *testing.T) {
+	t.Parallel()
+
+	rename := func(movieID, movieFileID int32, existing, updated string) radarr.RenameMovieResource {
+		r := radarr.NewRenameMovieResource()
+		r.SetMovieId(movieID)
+		r.SetMovieFileId(movieFileID)
+		r.SetExistingPath(existing)
+		r.SetNewPath(updated)
+
+		return *r
+	}
+
+	var diags diag.Diagnostics
+
+	preview := &RenamePreview{MovieID: types.Int64Null()}
+	preview.write(context.Background(), []radarr.RenameMovieResource{
+		rename(2, 5, "b.mkv", "B (2001).mkv"),
+		rename(1, 4, "a2.mkv", "A (2000) - 2.mkv"),
+		rename(1, 3, "a1.mkv", "A (2000) - 1.mkv"),
+	}, &diags)
+	assert.False(t, diags.HasError())
+
+	var renames []MovieRename
+
+	diags.Append(preview.Renames.ElementsAs(context.Background(), &renames, false)...)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "all", preview.ID.ValueString())
+	assert.Equal(t, []MovieRename{
+		{MovieID: types.Int64Value(1), MovieFileID: types.Int64Value(3), ExistingPath: types.StringValue("a1.mkv"), NewPath: types.StringValue("A (2000) - 1.mkv")},
+		{MovieID: types.Int64Value(1), MovieFileID: types.Int64Value(4), ExistingPath: types.StringValue("a2.mkv"), NewPath: types.StringValue("A (2000) - 2.mkv")},
+		{MovieID: types.Int64Value(2), MovieFileID: types.Int64Value(5), ExistingPath: types.StringValue("b.mkv"), NewPath: types.StringValue("B (2001).mkv")},
+	}, renames)
+}
+
+func TestRenamePreviewBatches(t *testing.T) {
+	t.Parallel()
+
+	var batches []int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v3/rename", r.URL.Path)
+
+		ids := r.URL.Query()["movieId"]
+		batches = append(batches, len(ids))
+
+		renames := make([]map[string]interface{}, 0, len(ids))
+		for _, id := range ids {
+			movieID, _ := strconv.Atoi(id)
+			renames = append(renames, map[string]interface{}{"movieId": movieID, "movieFileId": movieID, "existingPath": id + ".mkv", "newPath": id + ".mkv"})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(renames)
+	}))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	ctx := context.WithValue(context.Background(), radarr.ContextServerVariables, map[string]string{
+		"protocol": serverURL.Scheme,
+		"hostpath": serverURL.Host,
+	})
+	d := &RenamePreviewDataSource{client: radarr.NewAPIClient(radarr.NewConfiguration())}
+
+	movieIDs := make([]int32, 2*renamePreviewBatchSize+50)
+	for i := range movieIDs {
+		movieIDs[i] = int32(i + 1)
+	}
+
+	renames, err := d.listRenames(ctx, movieIDs)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{renamePreviewBatchSize, renamePreviewBatchSize, 50}, batches)
+	assert.Len(t, renames, len(movieIDs))
+
+	// every movie is previewed once.
+	previewed := make([]int32, len(renames))
+	for i, r := range renames {
+		previewed[i] = r.GetMovieId()
+	}
+
+	slices.Sort(previewed)
+	assert.Equal(t, movieIDs, previewed)
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const renderMovieNameFunctionName = "render_movie_name"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &RenderMovieNameFunction{}
+
+func NewRenderMovieNameFunction() function.Function {
+	return &RenderMovieNameFunction{}
+}
+
+// RenderMovieNameFunction defines the render movie name implementation.
+type RenderMovieNameFunction struct{}
+
+// namingMovieReader reads the naming values out of a movie object, keeping track of unknown values.
+type namingMovieReader struct {
+	movie   helpers.NamingMovie
+	unknown bool
+}
+
+func (f *RenderMovieNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = renderMovieNameFunctionName
+}
+
+func (f *RenderMovieNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Render a movie naming format",
+		MarkdownDescription: "Renders a [Naming](../resources/naming) movie or folder format for a movie, without calling Radarr. " +
+			"Values are cleaned as Radarr does when replacing illegal characters with the `smart` colon replacement. " +
+			"Fails on unknown tokens or unbalanced braces. " +
+			"Title tokens can be truncated (e.g. `{Movie Title:30}`), translated titles (e.g. `{Movie Title:DE}`) are not supported since they need the Radarr translations.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "format",
+				MarkdownDescription: "Naming format, such as `{Movie CleanTitle} ({Release Year}) {[Quality Full]}`.",
+			},
+			function.DynamicParameter{
+				Name: "movie",
+				MarkdownDescription: "Movie object, such as a `radarr_movie` resource or an object with any of: `title`, `original_title`, `year`, `imdb_id`, `tmdb_id`, `certification`, `collection` (string or object with `title`) and `movie_file`. " +
+					"File values are read from `movie_file` or from the movie itself: `quality`, `proper`, `repack`, `release_group`, `edition`, `custom_formats`, `scene_name`, `original_filename` and `media_info`, an object with `video_codec`, `video_bit_depth`, `video_dynamic_range_type`, `audio_codec`, `audio_channels`, `audio_languages`, `subtitle_languages` and `is_3d`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *RenderMovieNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		format string
+		movie  types.Dynamic
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &format, &movie))
+
+	if resp.Error != nil {
+		return
+	}
+
+	// the format is checked first, to report errors even when the movie is not known yet.
+	if err := helpers.ValidateNamingFormat(format); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+
+		return
+	}
+
+	reader := namingMovieReader{}
+	reader.read(movie.UnderlyingValue())
+
+	if reader.unknown {
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.StringUnknown()))
+
+		return
+	}
+
+	name, err := helpers.RenderMovieName(format, &reader.movie)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, name))
+}
+
+func (r *namingMovieReader) read(value attr.Value) {
+	movie := r.attributes(value)
+
+	r.movie.Title = r.string(movie["title"])
+	r.movie.OriginalTitle = r.string(movie["original_title"])
+	r.movie.Certification = r.string(movie["certification"])
+	r.movie.IMDBID = r.string(movie["imdb_id"])
+	r.movie.Year = r.int(movie["year"])
+	r.movie.TMDBID = r.int(movie["tmdb_id"])
+
+	if collection := r.attributes(movie["collection"]); collection != nil {
+		r.movie.Collection = r.string(collection["title"])
+	} else {
+		r.movie.Collection = r.string(movie["collection"])
+	}
+
+	// values set on the movie itself take precedence over the movie file ones.
+	r.readFile(r.attributes(movie["movie_file"]))
+	r.readFile(movie)
+}
+
+func (r *namingMovieReader) readFile(file map[string]attr.Value) {
+	setString(&r.movie.Quality, r.string(file["quality"]))
+	setString(&r.movie.ReleaseGroup, r.string(file["release_group"]))
+	setString(&r.movie.Edition, r.string(file["edition"]))
+	setString(&r.movie.SceneName, r.string(file["scene_name"]))
+	setString(&r.movie.OriginalFilename, r.string(file["original_filename"]))
+
+	if formats := r.strings(file["custom_formats"]); formats != nil {
+		r.movie.CustomFormats = formats
+	}
+
+	r.movie.Proper = r.movie.Proper || r.bool(file["proper"])
+	r.movie.Repack = r.movie.Repack || r.bool(file["repack"])
+
+	mediaInfo := r.attributes(file["media_info"])
+	if mediaInfo == nil {
+		return
+	}
+
+	setString(&r.movie.VideoCodec, r.string(mediaInfo["video_codec"]))
+	setString(&r.movie.VideoDynamicRangeType, r.string(mediaInfo["video_dynamic_range_type"]))
+	setString(&r.movie.AudioCodec, r.string(mediaInfo["audio_codec"]))
+	setString(&r.movie.AudioChannels, r.string(mediaInfo["audio_channels"]))
+
+	if depth := r.int(mediaInfo["video_bit_depth"]); depth != 0 {
+		r.movie.VideoBitDepth = depth
+	}
+
+	if languages := r.strings(mediaInfo["audio_languages"]); languages != nil {
+		r.movie.AudioLanguages = languages
+	}
+
+	if languages := r.strings(mediaInfo["subtitle_languages"]); languages != nil {
+		r.movie.SubtitleLanguages = languages
+	}
+
+	r.movie.Is3D = r.movie.Is3D || r.bool(mediaInfo["is_3d"])
+}
+
+// known reports whether the value is set, flagging unknown values.
+func (r *namingMovieReader) known(value attr.Value) bool {
+	if value == nil || value.IsNull() {
+		return false
+	}
+
+	if value.IsUnknown() {
+		r.unknown = true
+
+		return false
+	}
+
+	return true
+}
+
+func (r *namingMovieReader) attributes(value attr.Value) map[string]attr.Value {
+	if !r.known(value) {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case types.Object:
+		return v.Attributes()
+	case types.Map:
+		return v.Elements()
+	case types.Dynamic:
+		return r.attributes(v.UnderlyingValue())
+	default:
+		return nil
+	}
+}
+
+func (r *namingMovieReader) string(value attr.Value) string {
+	if !r.known(value) {
+		return ""
+	}
+
+	switch v := value.(type) {
+	case types.String:
+		return v.ValueString()
+	case types.Number:
+		return v.ValueBigFloat().Text('f', -1)
+	case types.Int64:
+		return strconv.FormatInt(v.ValueInt64(), 10)
+	case types.Float64:
+		return strconv.FormatFloat(v.ValueFloat64(), 'f', -1, 64)
+	case types.Dynamic:
+		return r.string(v.UnderlyingValue())
+	default:
+		return ""
+	}
+}
+
+func (r *namingMovieReader) int(value attr.Value) int64 {
+	if !r.known(value) {
+		return 0
+	}
+
+	switch v := value.(type) {
+	case types.Number:
+		number, _ := v.ValueBigFloat().Int64()
+
+		return number
+	case types.Int64:
+		return v.ValueInt64()
+	case types.String:
+		number, _ := strconv.ParseInt(v.ValueString(), 10, 64)
+
+		return number
+	case types.Dynamic:
+		return r.int(v.UnderlyingValue())
+	default:
+		return 0
+	}
+}
+
+func (r *namingMovieReader) bool(value attr.Value) bool {
+	if !r.known(value) {
+		return false
+	}
+
+	switch v := value.(type) {
+	case types.Bool:
+		return v.ValueBool()
+	case types.Dynamic:
+		return r.bool(v.UnderlyingValue())
+	default:
+		return false
+	}
+}
+
+func (r *namingMovieReader) strings(value attr.Value) []string {
+	if !r.known(value) {
+		return nil
+	}
+
+	var elements []attr.Value
+
+	switch v := value.(type) {
+	case types.List:
+		elements = v.Elements()
+	case types.Set:
+		elements = v.Elements()
+	case types.Tuple:
+		elements = v.Elements()
+	case types.Dynamic:
+		return r.strings(v.UnderlyingValue())
+	default:
+		return nil
+	}
+
+	result := make([]string, 0, len(elements))
+	for _, e := range elements {
+		result = append(result, r.string(e))
+	}
+
+	return result
+}
+
+func setString(target *string, value string) {
+	if value != "" {
+		*target = value
+	}
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccRenderMovieNameFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			// Unknown token
+			{
+				Config:      testAccRenderMovieNameFunctionConfig("{Movie Titel}"),
+				ExpectError: regexp.MustCompile("unknown token"),
+			},
+			// Render testing
+			{
+				Config: testAccRenderMovieNameFunctionConfig("{Movie CleanTitle} {(Release Year)} {imdb-{ImdbId}} {edition-{Edition Tags}} {[Quality Full]}{[MediaInfo VideoCodec]}{-Release Group}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("name", "The Movie Title (2010) {imdb-tt0066921} {edition-Ultimate Extended Edition} [Bluray-1080p Proper][x264]-EVOLVE"),
+				),
+			},
+			// Movie file testing
+			{
+				Config: testAccMovieResourceConfig("Fight Club", "Fight_Club_1999", 550) + testAccMovieFilesDataSourceConfig + testAccRenderMovieNameFunctionFileConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("file_name", "Fight Club (1999)"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRenderMovieNameFunctionConfig(format string) string {
+	return `
+	output "name" {
+		value = provider::radarr::render_movie_name("` + format + `", {
+			title   = "The Movie: Title"
+			year    = 2010
+			imdb_id = "tt0066921"
+			movie_file = {
+				quality       = "Bluray-1080p"
+				proper        = true
+				release_group = "EVOLVE"
+				edition       = "Ultimate extended edition"
+				media_info = {
+					video_codec = "x264"
+				}
+			}
+		})
+	}`
+}
+
+// testAccRenderMovieNameFunctionFileConfig renders the movie with its file, as read by the movie files data source.
+const testAccRenderMovieNameFunctionFileConfig = `
+	output "file_name" {
+		value = provider::radarr::render_movie_name("{Movie CleanTitle} ({Release Year}) {[Quality Full]}{[MediaInfo 3D]}{MediaInfo SubtitleLanguages}{-Release Group}", merge(radarr_movie.test, {
+			movie_file = one(data.radarr_movie_files.test.files)
+		}))
+	}
+`
@@ -23,7 +23,7 @@ func NewRootFolderDataSource() datasource.DataSource {
 // RootFolderDataSource defines the root folders implementation.
 type RootFolderDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *RootFolderDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -75,6 +75,8 @@ func (d *RootFolderDataSource) Configure(ctx context.Context, req datasource.Con
 }
 
 func (d *RootFolderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	var folder *RootFolder
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &folder)...)
@@ -83,7 +85,7 @@ func (d *RootFolderDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 	// Get rootfolders current value
-	response, _, err := d.client.RootFolderAPI.ListRootFolder(d.auth).Execute()
+	response, _, err := d.client.RootFolderAPI.ListRootFolder(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, rootFolderDataSourceName, err))
 
@@ -33,7 +33,7 @@ func NewRootFolderResource() resource.Resource {
 // RootFolderResource defines the root folder implementation.
 type RootFolderResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // RootFolder describes the root folder data model.
@@ -44,6 +44,12 @@ type RootFolder struct {
 	Accessible      types.Bool   `tfsdk:"accessible"`
 }
 
+// RootFolderResourceModel describes the root folder resource data model.
+type RootFolderResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	RootFolder
+}
+
 func (r RootFolder) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -76,6 +82,7 @@ func (r *RootFolderResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Media Management -->\nRoot Folder resource.\nFor more information refer to [Root Folders](https://wiki.servarr.com/radarr/settings#root-folders) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			// TODO: add validator
 			"path": schema.StringAttribute{
 				MarkdownDescription: "Root Folder absolute path.",
@@ -129,8 +136,11 @@ func (r *RootFolderResource) Configure(ctx context.Context, req resource.Configu
 }
 
 func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var folder *RootFolder
+	var folder *RootFolderResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &folder)...)
 
@@ -142,9 +152,9 @@ func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequ
 	request := *radarr.NewRootFolderResource()
 	request.SetPath(folder.Path.ValueString())
 
-	response, _, err := r.client.RootFolderAPI.CreateRootFolder(r.auth).RootFolderResource(request).Execute()
+	response, _, err := r.client.RootFolderAPI.CreateRootFolder(ctx).RootFolderResource(request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, rootFolderResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, rootFolderResourceName, err)
 
 		return
 	}
@@ -156,8 +166,11 @@ func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *RootFolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var folder *RootFolder
+	var folder *RootFolderResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &folder)...)
 
@@ -166,8 +179,12 @@ func (r *RootFolderResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get rootFolder current value
-	response, _, err := r.client.RootFolderAPI.GetRootFolderById(r.auth, int32(folder.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.RootFolderAPI.GetRootFolderById(ctx, int32(folder.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, rootFolderResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, rootFolderResourceName, err))
 
 		return
@@ -184,6 +201,9 @@ func (r *RootFolderResource) Update(_ context.Context, _ resource.UpdateRequest,
 }
 
 func (r *RootFolderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -193,7 +213,7 @@ func (r *RootFolderResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	// Delete rootFolder current value
-	_, err := r.client.RootFolderAPI.DeleteRootFolder(r.auth, int32(ID)).Execute()
+	_, err := r.client.RootFolderAPI.DeleteRootFolder(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, rootFolderResourceName, err))
 
@@ -205,10 +225,24 @@ func (r *RootFolderResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *RootFolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), rootFolderImportLookups(r.auth.apiContext(ctx), r.client), req, resp)
 	tflog.Trace(ctx, "imported "+rootFolderResourceName+": "+req.ID)
 }
 
+// rootFolderImportLookups resolves the root folder import identifiers.
+func rootFolderImportLookups(ctx context.Context, client *radarr.APIClient) map[string]helpers.ImportLookup {
+	return map[string]helpers.ImportLookup{
+		"path=": func(path string) (int, error) {
+			folders, _, err := client.RootFolderAPI.ListRootFolder(ctx).Execute()
+			if err != nil {
+				return 0, err
+			}
+
+			return helpers.FindImportID(folders, path, (*radarr.RootFolderResource).GetPath)
+		},
+	}
+}
+
 func (r *RootFolder) write(ctx context.Context, rootFolder *radarr.RootFolderResource, diags *diag.Diagnostics) {
 	var tempDiag diag.Diagnostics
 
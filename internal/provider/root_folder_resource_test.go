@@ -46,6 +46,13 @@ func TestAccRootFolderResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by path testing
+			{
+				ResourceName:      "radarr_root_folder.test",
+				ImportStateId:     "path=/config/logs",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -24,7 +24,7 @@ func NewRootFoldersDataSource() datasource.DataSource {
 // RootFoldersDataSource defines the root folders implementation.
 type RootFoldersDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // RootFolders describes the root folders data model.
@@ -94,8 +94,10 @@ func (d *RootFoldersDataSource) Configure(ctx context.Context, req datasource.Co
 }
 
 func (d *RootFoldersDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get rootfolders current value
-	response, _, err := d.client.RootFolderAPI.ListRootFolder(d.auth).Execute()
+	response, _, err := d.client.RootFolderAPI.ListRootFolder(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, rootFoldersDataSourceName, err))
 
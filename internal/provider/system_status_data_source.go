@@ -23,7 +23,7 @@ func NewSystemStatusDataSource() datasource.DataSource {
 // SystemStatusDataSource defines the system status implementation.
 type SystemStatusDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // SystemStatus describes the system status data model.
@@ -202,8 +202,10 @@ func (d *SystemStatusDataSource) Configure(ctx context.Context, req datasource.C
 }
 
 func (d *SystemStatusDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get naming current value
-	response, _, err := d.client.SystemAPI.GetSystemStatus(d.auth).Execute()
+	response, _, err := d.client.SystemAPI.GetSystemStatus(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, systemStatusDataSourceName, err))
 
@@ -23,7 +23,7 @@ func NewTagDataSource() datasource.DataSource {
 // TagDataSource defines the tag implementation.
 type TagDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 func (d *TagDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -55,6 +55,8 @@ func (d *TagDataSource) Configure(ctx context.Context, req datasource.ConfigureR
 }
 
 func (d *TagDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	var data *Tag
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -64,7 +66,7 @@ func (d *TagDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	}
 
 	// Get tags current value
-	response, _, err := d.client.TagAPI.ListTag(d.auth).Execute()
+	response, _, err := d.client.TagAPI.ListTag(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagDataSourceName, err))
 
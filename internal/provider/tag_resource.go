@@ -35,7 +35,7 @@ func NewTagResource() resource.Resource {
 // TagResource defines the tag implementation.
 type TagResource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // Tag describes the tag data model.
@@ -44,6 +44,12 @@ type Tag struct {
 	ID    types.Int64  `tfsdk:"id"`
 }
 
+// TagResourceModel describes the tag resource data model.
+type TagResourceModel struct {
+	Timeouts types.Object `tfsdk:"timeouts"`
+	Tag
+}
+
 func (t Tag) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -60,6 +66,7 @@ func (r *TagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Tags -->\nTag resource.\nFor more information refer to [Tags](https://wiki.servarr.com/radarr/settings#tags) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": helpers.TimeoutsAttribute(),
 			"label": schema.StringAttribute{
 				MarkdownDescription: "Tag label. It must be lowercase.",
 				Required:            true,
@@ -89,8 +96,11 @@ func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureReque
 }
 
 func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Retrieve values from plan
-	var tag *Tag
+	var tag *TagResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &tag)...)
 
@@ -102,9 +112,9 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 	request := *radarr.NewTagResource()
 	request.SetLabel(tag.Label.ValueString())
 
-	response, _, err := r.client.TagAPI.CreateTag(r.auth).TagResource(request).Execute()
+	response, _, err := r.client.TagAPI.CreateTag(ctx).TagResource(request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, tagResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Create, tagResourceName, err)
 
 		return
 	}
@@ -116,8 +126,11 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 }
 
 func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get current state
-	var tag *Tag
+	var tag *TagResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &tag)...)
 
@@ -126,8 +139,12 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	// Get tag current value
-	response, _, err := r.client.TagAPI.GetTagById(r.auth, int32(tag.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.TagAPI.GetTagById(ctx, int32(tag.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.RemoveNotFoundResource(ctx, tagResourceName, httpResp, err, resp) {
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagResourceName, err))
 
 		return
@@ -140,8 +157,11 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 }
 
 func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.Plan, helpers.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Get plan values
-	var tag *Tag
+	var tag *TagResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &tag)...)
 
@@ -154,9 +174,9 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	tagResource.SetLabel(tag.Label.ValueString())
 	tagResource.SetId(int32(tag.ID.ValueInt64()))
 
-	response, _, err := r.client.TagAPI.UpdateTag(r.auth, fmt.Sprint(tagResource.GetId())).TagResource(tagResource).Execute()
+	response, _, err := r.client.TagAPI.UpdateTag(ctx, fmt.Sprint(tagResource.GetId())).TagResource(tagResource).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, tagResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Update, tagResourceName, err)
 
 		return
 	}
@@ -168,6 +188,9 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 }
 
 func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := r.auth.operationContext(ctx, req.State, helpers.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	var ID int64
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
@@ -177,7 +200,7 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	}
 
 	// Delete tag current value
-	_, err := r.client.TagAPI.DeleteTag(r.auth, int32(ID)).Execute()
+	_, err := r.client.TagAPI.DeleteTag(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, tagResourceName, err))
 
@@ -189,10 +212,24 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateLookupIntID(ctx, path.Root("id"), tagImportLookups(r.auth.apiContext(ctx), r.client), req, resp)
 	tflog.Trace(ctx, "imported "+tagResourceName+": "+req.ID)
 }
 
+// tagImportLookups resolves the tag import identifiers.
+func tagImportLookups(ctx context.Context, client *radarr.APIClient) map[string]helpers.ImportLookup {
+	return map[string]helpers.ImportLookup{
+		"label=": func(label string) (int, error) {
+			tags, _, err := client.TagAPI.ListTag(ctx).Execute()
+			if err != nil {
+				return 0, err
+			}
+
+			return helpers.FindImportID(tags, label, (*radarr.TagResource).GetLabel)
+		},
+	}
+}
+
 func (t *Tag) write(tag *radarr.TagResource) {
 	t.ID = types.Int64Value(int64(tag.GetId()))
 	t.Label = types.StringValue(tag.GetLabel())
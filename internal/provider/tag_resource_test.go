@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -47,6 +48,20 @@ func TestAccTagResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by label testing
+			{
+				ResourceName:      "radarr_tag.test",
+				ImportStateId:     "label=1080p",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Remote deletion testing
+			{
+				PreConfig:          func() { tagDelete("1080p") },
+				Config:             testAccTagResourceConfig("test", "1080p"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -59,3 +74,15 @@ func testAccTagResourceConfig(name, label string) string {
 		}
 	`, name, label)
 }
+
+func tagDelete(label string) {
+	// delete the tag outside of terraform to simulate drift
+	client := testAccAPIClient()
+
+	tags, _, _ := client.TagAPI.ListTag(context.TODO()).Execute()
+	for _, t := range tags {
+		if t.GetLabel() == label {
+			_, _ = client.TagAPI.DeleteTag(context.TODO(), t.GetId()).Execute()
+		}
+	}
+}
@@ -24,7 +24,7 @@ func NewTagsDataSource() datasource.DataSource {
 // TagsDataSource defines the tags implementation.
 type TagsDataSource struct {
 	client *radarr.APIClient
-	auth   context.Context
+	auth   radarrAuth
 }
 
 // Tags describes the tags data model.
@@ -74,8 +74,10 @@ func (d *TagsDataSource) Configure(ctx context.Context, req datasource.Configure
 }
 
 func (d *TagsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = d.auth.apiContext(ctx)
+
 	// Get tags current value
-	response, _, err := d.client.TagAPI.ListTag(d.auth).Execute()
+	response, _, err := d.client.TagAPI.ListTag(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, tagsDataSourceName, err))
 